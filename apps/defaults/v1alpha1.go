@@ -0,0 +1,165 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaults
+
+import (
+	"math"
+
+	"github.com/openkruise/kruise-api/apps/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// SetDefaults_CloneSet sets the defaults of CloneSet, which are the same as the Kruise webhook applies.
+// Note that the defaults of pod template are not set here, which rely on the defaulting of core types.
+func SetDefaults_CloneSet(obj *v1alpha1.CloneSet) {
+	SetDefaults_CloneSetSpec(&obj.Spec)
+}
+
+// SetDefaults_CloneSetSpec sets the defaults of CloneSetSpec.
+// ScaleStrategy.MaxUnavailable is left unset, which means no limit during scale out.
+func SetDefaults_CloneSetSpec(spec *v1alpha1.CloneSetSpec) {
+	if spec.Replicas == nil {
+		spec.Replicas = int32Ptr(1)
+	}
+	if spec.RevisionHistoryLimit == nil {
+		spec.RevisionHistoryLimit = int32Ptr(10)
+	}
+
+	SetDefaults_CloneSetUpdateStrategy(&spec.UpdateStrategy)
+
+	if len(spec.VolumeClaimUpdateStrategy.Type) == 0 {
+		spec.VolumeClaimUpdateStrategy.Type = v1alpha1.RecreateCloneSetVolumeClaimUpdateStrategyType
+	}
+}
+
+// SetDefaults_CloneSetUpdateStrategy sets the defaults of CloneSetUpdateStrategy.
+// Partition is left unset if PartitionSelector is specified.
+func SetDefaults_CloneSetUpdateStrategy(strategy *v1alpha1.CloneSetUpdateStrategy) {
+	if len(strategy.Type) == 0 {
+		strategy.Type = v1alpha1.RecreateCloneSetUpdateStrategyType
+	}
+	if strategy.Partition == nil && strategy.PartitionSelector == nil {
+		partition := intstr.FromInt(0)
+		strategy.Partition = &partition
+	}
+	if strategy.MaxUnavailable == nil {
+		maxUnavailable := intstr.FromString(v1alpha1.DefaultCloneSetMaxUnavailable)
+		strategy.MaxUnavailable = &maxUnavailable
+	}
+	if strategy.MaxSurge == nil {
+		maxSurge := intstr.FromInt(0)
+		strategy.MaxSurge = &maxSurge
+	}
+	if len(strategy.RecreatePolicy) == 0 {
+		strategy.RecreatePolicy = v1alpha1.DeleteCloneSetRecreatePolicyType
+	}
+}
+
+// SetDefaults_SidecarSet sets the defaults of SidecarSet, which are the same as the Kruise webhook applies.
+func SetDefaults_SidecarSet(obj *v1alpha1.SidecarSet) {
+	SetDefaults_SidecarSetSpec(&obj.Spec)
+}
+
+// SetDefaults_SidecarSetSpec sets the defaults of SidecarSetSpec.
+func SetDefaults_SidecarSetSpec(spec *v1alpha1.SidecarSetSpec) {
+	for i := range spec.InitContainers {
+		SetDefaults_SidecarContainer(&spec.InitContainers[i])
+	}
+	for i := range spec.Containers {
+		SetDefaults_SidecarContainer(&spec.Containers[i])
+	}
+}
+
+// SetDefaults_SidecarContainer sets the defaults of SidecarContainer.
+// ShareVolumePolicy is left unset, which can not be specified together with VolumePolicy.
+func SetDefaults_SidecarContainer(container *v1alpha1.SidecarContainer) {
+	if len(container.PodInjectPolicy) == 0 {
+		container.PodInjectPolicy = v1alpha1.BeforeAppContainerType
+	}
+	if len(container.UpgradeStrategy.UpgradeType) == 0 {
+		container.UpgradeStrategy.UpgradeType = v1alpha1.SidecarContainerColdUpgrade
+	}
+	if len(container.EnvMergePolicy) == 0 {
+		container.EnvMergePolicy = v1alpha1.OverrideSidecarMergePolicyType
+	}
+	if len(container.ResourcesMergePolicy) == 0 {
+		container.ResourcesMergePolicy = v1alpha1.OverrideSidecarMergePolicyType
+	}
+}
+
+// SetDefaults_BroadcastJob sets the defaults of BroadcastJob, which are the same as the Kruise webhook applies.
+// Note that the defaults of pod template are not set here, which rely on the defaulting of core types.
+func SetDefaults_BroadcastJob(obj *v1alpha1.BroadcastJob) {
+	SetDefaults_BroadcastJobSpec(&obj.Spec)
+}
+
+// SetDefaults_BroadcastJobSpec sets the defaults of BroadcastJobSpec. Parallelism defaults to MaxInt32,
+// which means no limit, and completionPolicy.ttlSecondsAfterFinished is left unset, which means
+// the finished job won't be automatically deleted.
+func SetDefaults_BroadcastJobSpec(spec *v1alpha1.BroadcastJobSpec) {
+	if spec.Parallelism == nil {
+		parallelism := intstr.FromInt(math.MaxInt32)
+		spec.Parallelism = &parallelism
+	}
+	if len(spec.CompletionPolicy.Type) == 0 {
+		spec.CompletionPolicy.Type = v1alpha1.Always
+	}
+	if len(spec.FailurePolicy.Type) == 0 {
+		spec.FailurePolicy.Type = v1alpha1.FailurePolicyTypeFailFast
+	}
+}
+
+// SetDefaults_AdvancedCronJob sets the defaults of AdvancedCronJob, which are the same as the Kruise webhook applies.
+// Note that the defaults of pod template are not set here, which rely on the defaulting of core types.
+func SetDefaults_AdvancedCronJob(obj *v1alpha1.AdvancedCronJob) {
+	SetDefaults_AdvancedCronJobSpec(&obj.Spec)
+}
+
+// SetDefaults_AdvancedCronJobSpec sets the defaults of AdvancedCronJobSpec. The default concurrencyPolicy
+// depends on the template kind, BroadcastJob forbids concurrent runs since each run occupies all the
+// selected nodes, while the others allow them.
+func SetDefaults_AdvancedCronJobSpec(spec *v1alpha1.AdvancedCronJobSpec) {
+	if len(spec.ConcurrencyPolicy) == 0 {
+		spec.ConcurrencyPolicy = DefaultConcurrencyPolicy(spec.Template.GetTemplateKind())
+	}
+	if len(spec.MissedRunPolicy) == 0 {
+		spec.MissedRunPolicy = v1alpha1.RunOnceMissedRunPolicyType
+	}
+	if spec.Paused == nil {
+		paused := false
+		spec.Paused = &paused
+	}
+	if spec.SuccessfulJobsHistoryLimit == nil {
+		spec.SuccessfulJobsHistoryLimit = int32Ptr(3)
+	}
+	if spec.FailedJobsHistoryLimit == nil {
+		spec.FailedJobsHistoryLimit = int32Ptr(1)
+	}
+	if spec.Template.BroadcastJobTemplate != nil {
+		SetDefaults_BroadcastJobSpec(&spec.Template.BroadcastJobTemplate.Spec)
+	}
+}
+
+// DefaultConcurrencyPolicy returns the default concurrencyPolicy of AdvancedCronJob with the given template kind.
+func DefaultConcurrencyPolicy(kind v1alpha1.TemplateKind) v1alpha1.ConcurrencyPolicy {
+	switch kind {
+	case v1alpha1.BroadcastJobTemplate:
+		return v1alpha1.ForbidConcurrent
+	default:
+		return v1alpha1.AllowConcurrent
+	}
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaults
+
+import (
+	"github.com/openkruise/kruise-api/apps/v1beta1"
+	apps "k8s.io/api/apps/v1"
+)
+
+// SetDefaults_StatefulSet sets the defaults of Advanced StatefulSet, which are the same as the Kruise webhook applies.
+// Note that the defaults of pod template are not set here, which rely on the defaulting of core types.
+func SetDefaults_StatefulSet(obj *v1beta1.StatefulSet) {
+	SetDefaults_StatefulSetSpec(&obj.Spec)
+}
+
+// SetDefaults_StatefulSetSpec sets the defaults of StatefulSetSpec.
+// ScaleStrategy is left unset on purpose: a nil scaleStrategy.maxUnavailable means scaling is not limited,
+// and a non-nil one can just work with Parallel podManagementPolicy, see ValidateParallelRequirements.
+func SetDefaults_StatefulSetSpec(spec *v1beta1.StatefulSetSpec) {
+	if len(spec.PodManagementPolicy) == 0 {
+		spec.PodManagementPolicy = apps.OrderedReadyPodManagement
+	}
+	if spec.Replicas == nil {
+		spec.Replicas = int32Ptr(1)
+	}
+	if spec.RevisionHistoryLimit == nil {
+		spec.RevisionHistoryLimit = int32Ptr(10)
+	}
+	if spec.MinReadySeconds == nil {
+		spec.MinReadySeconds = int32Ptr(0)
+	}
+
+	SetDefaults_StatefulSetUpdateStrategy(&spec.UpdateStrategy)
+
+	if spec.PersistentVolumeClaimRetentionPolicy == nil {
+		spec.PersistentVolumeClaimRetentionPolicy = &v1beta1.StatefulSetPersistentVolumeClaimRetentionPolicy{}
+	}
+	SetDefaults_StatefulSetPersistentVolumeClaimRetentionPolicy(spec.PersistentVolumeClaimRetentionPolicy)
+
+	if len(spec.VolumeClaimUpdateStrategy.Type) == 0 {
+		spec.VolumeClaimUpdateStrategy.Type = v1beta1.OnDeleteVolumeClaimUpdateStrategyType
+	}
+}
+
+// SetDefaults_StatefulSetUpdateStrategy sets the defaults of StatefulSetUpdateStrategy,
+// and RollingUpdate will be initialized if the type is RollingUpdate.
+func SetDefaults_StatefulSetUpdateStrategy(strategy *v1beta1.StatefulSetUpdateStrategy) {
+	if len(strategy.Type) == 0 {
+		strategy.Type = apps.RollingUpdateStatefulSetStrategyType
+	}
+	if strategy.Type != apps.RollingUpdateStatefulSetStrategyType {
+		return
+	}
+
+	if strategy.RollingUpdate == nil {
+		strategy.RollingUpdate = &v1beta1.RollingUpdateStatefulSetStrategy{}
+	}
+	SetDefaults_RollingUpdateStatefulSetStrategy(strategy.RollingUpdate)
+}
+
+// SetDefaults_RollingUpdateStatefulSetStrategy sets the defaults of RollingUpdateStatefulSetStrategy.
+// Partition is left unset if PartitionPercent is specified, and MaxUnavailable is left unset since
+// it can just work with Parallel podManagementPolicy.
+// MinReadySeconds is left unset so that it falls back to spec.minReadySeconds, the resolution order is
+// rollingUpdate.minReadySeconds, spec.minReadySeconds and 0, see StatefulSetSpec.GetRollingUpdateMinReadySeconds.
+func SetDefaults_RollingUpdateStatefulSetStrategy(rollingUpdate *v1beta1.RollingUpdateStatefulSetStrategy) {
+	if rollingUpdate.Partition == nil && rollingUpdate.PartitionPercent == nil {
+		rollingUpdate.Partition = int32Ptr(0)
+	}
+	if len(rollingUpdate.PodUpdatePolicy) == 0 {
+		rollingUpdate.PodUpdatePolicy = v1beta1.RecreatePodUpdateStrategyType
+	}
+}
+
+// SetDefaults_StatefulSetPersistentVolumeClaimRetentionPolicy sets the defaults of
+// StatefulSetPersistentVolumeClaimRetentionPolicy, and both policies default to Retain.
+func SetDefaults_StatefulSetPersistentVolumeClaimRetentionPolicy(policy *v1beta1.StatefulSetPersistentVolumeClaimRetentionPolicy) {
+	if len(policy.WhenDeleted) == 0 {
+		policy.WhenDeleted = v1beta1.RetainPersistentVolumeClaimRetentionPolicyType
+	}
+	if len(policy.WhenScaled) == 0 {
+		policy.WhenScaled = v1beta1.RetainPersistentVolumeClaimRetentionPolicyType
+	}
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
@@ -17,7 +17,11 @@ limitations under the License.
 package pub
 
 import (
+	"fmt"
+	"strconv"
+
 	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -65,6 +69,97 @@ type InPlaceUpdateStrategy struct {
 	// GracePeriodSeconds is the timespan between set Pod status to not-ready and update images in Pod spec
 	// when in-place update a Pod.
 	GracePeriodSeconds int32 `json:"gracePeriodSeconds,omitempty"`
+	// MarkPodNotReady indicates whether to mark Pod as not-ready during in-place update, by injecting the
+	// InPlaceUpdateReady readinessGate into Pods and setting its condition to False before update.
+	// If false, Pods without the InPlaceUpdateReady readinessGate in template keep ready during in-place update.
+	// +optional
+	MarkPodNotReady bool `json:"markPodNotReady,omitempty"`
+	// Features are the additional kinds of changes in Pod spec that are allowed to be in-place updated.
+	// Image and metadata (labels and annotations) changes are always allowed.
+	// +optional
+	Features []InPlaceUpdateFeature `json:"features,omitempty"`
+}
+
+// InPlaceUpdateFeature is a kind of change in Pod spec that can be in-place updated.
+type InPlaceUpdateFeature string
+
+const (
+	// InPlaceUpdateResourcesFeature indicates that the resources of containers can be in-place updated,
+	// which means vertical scaling (CPU/memory resize) without recreating the Pod.
+	InPlaceUpdateResourcesFeature InPlaceUpdateFeature = "Resources"
+)
+
+// HasFeature returns true if the given feature is enabled in the strategy.
+func (s *InPlaceUpdateStrategy) HasFeature(feature InPlaceUpdateFeature) bool {
+	if s == nil {
+		return false
+	}
+	for _, f := range s.Features {
+		if f == feature {
+			return true
+		}
+	}
+	return false
+}
+
+// DiffInPlaceUpdateCompatibility compares the old and new Pod templates, and reports the paths of
+// changed fields that can be in-place updated under the strategy and those that can not.
+// Image and metadata changes are always compatible, and resources changes are compatible only
+// if InPlaceUpdateResourcesFeature is enabled.
+func DiffInPlaceUpdateCompatibility(strategy *InPlaceUpdateStrategy, oldTemplate, newTemplate *v1.PodTemplateSpec) (compatible, incompatible []string) {
+	if !apiequality.Semantic.DeepEqual(oldTemplate.Labels, newTemplate.Labels) {
+		compatible = append(compatible, "metadata.labels")
+	}
+	if !apiequality.Semantic.DeepEqual(oldTemplate.Annotations, newTemplate.Annotations) {
+		compatible = append(compatible, "metadata.annotations")
+	}
+
+	oldSpec, newSpec := oldTemplate.Spec.DeepCopy(), newTemplate.Spec.DeepCopy()
+	if len(oldSpec.Containers) != len(newSpec.Containers) {
+		incompatible = append(incompatible, "spec.containers")
+		return
+	}
+	for i := range newSpec.Containers {
+		oldContainer, newContainer := &oldSpec.Containers[i], &newSpec.Containers[i]
+		if oldContainer.Name != newContainer.Name {
+			incompatible = append(incompatible, "spec.containers")
+			return
+		}
+		path := fmt.Sprintf("spec.containers[%s]", newContainer.Name)
+
+		if oldContainer.Image != newContainer.Image {
+			compatible = append(compatible, path+".image")
+			oldContainer.Image = newContainer.Image
+		}
+		if !apiequality.Semantic.DeepEqual(oldContainer.Resources, newContainer.Resources) {
+			if strategy.HasFeature(InPlaceUpdateResourcesFeature) {
+				compatible = append(compatible, path+".resources")
+			} else {
+				incompatible = append(incompatible, path+".resources")
+			}
+			oldContainer.Resources = newContainer.Resources
+		}
+		if !apiequality.Semantic.DeepEqual(oldContainer, newContainer) {
+			incompatible = append(incompatible, path)
+		}
+	}
+
+	oldSpec.Containers, newSpec.Containers = nil, nil
+	if !apiequality.Semantic.DeepEqual(oldSpec, newSpec) {
+		incompatible = append(incompatible, "spec")
+	}
+	return
+}
+
+// Describe returns a one-line summary of the in-place update strategy.
+func (s *InPlaceUpdateStrategy) Describe() string {
+	if s == nil {
+		return "none"
+	}
+	if s.GracePeriodSeconds <= 0 {
+		return "in-place (no grace)"
+	}
+	return "in-place with " + strconv.FormatInt(int64(s.GracePeriodSeconds), 10) + "s grace"
 }
 
 func GetInPlaceUpdateState(obj metav1.Object) (string, bool) {
@@ -0,0 +1,41 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pub
+
+import (
+	"testing"
+)
+
+func TestInPlaceUpdateStrategyDescribe(t *testing.T) {
+	cases := []struct {
+		name     string
+		strategy *InPlaceUpdateStrategy
+		expected string
+	}{
+		{name: "nil", expected: "none"},
+		{name: "zero grace", strategy: &InPlaceUpdateStrategy{}, expected: "in-place (no grace)"},
+		{name: "non-zero grace", strategy: &InPlaceUpdateStrategy{GracePeriodSeconds: 30}, expected: "in-place with 30s grace"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.strategy.Describe(); got != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
@@ -16,10 +16,17 @@ limitations under the License.
 
 package pub
 
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
 const (
 	LifecycleStateKey     = "lifecycle.apps.kruise.io/state"
 	LifecycleTimestampKey = "lifecycle.apps.kruise.io/timestamp"
 
+	LifecycleStatePreparingNormal LifecycleStateType = "PreparingNormal"
 	LifecycleStateNormal          LifecycleStateType = "Normal"
 	LifecycleStatePreparingUpdate LifecycleStateType = "PreparingUpdate"
 	LifecycleStateUpdating        LifecycleStateType = "Updating"
@@ -31,6 +38,8 @@ type LifecycleStateType string
 
 // Lifecycle contains the hooks for Pod lifecycle.
 type Lifecycle struct {
+	// PreNormal is the hook after Pod to be created and ready to be Normal.
+	PreNormal *LifecycleHook `json:"preNormal,omitempty"`
 	// PreDelete is the hook before Pod to be deleted.
 	PreDelete *LifecycleHook `json:"preDelete,omitempty"`
 	// InPlaceUpdate is the hook before Pod to update and after Pod has been updated.
@@ -41,3 +50,38 @@ type LifecycleHook struct {
 	LabelsHandler     map[string]string `json:"labelsHandler,omitempty"`
 	FinalizersHandler []string          `json:"finalizersHandler,omitempty"`
 }
+
+// GetPodLifecycleState returns the lifecycle state of Pod, which is empty if not set.
+func GetPodLifecycleState(obj metav1.Object) LifecycleStateType {
+	return LifecycleStateType(obj.GetLabels()[LifecycleStateKey])
+}
+
+// SetPodLifecycleState sets the lifecycle state of Pod, along with the timestamp of transition.
+// If state is unchanged, the timestamp is kept.
+func SetPodLifecycleState(obj metav1.Object, state LifecycleStateType) {
+	if GetPodLifecycleState(obj) == state {
+		return
+	}
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[LifecycleStateKey] = string(state)
+	obj.SetLabels(labels)
+
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[LifecycleTimestampKey] = time.Now().Format(time.RFC3339)
+	obj.SetAnnotations(annotations)
+}
+
+// NextLifecycleStateAfterCreated returns the state that a newly created Pod should be in,
+// which is PreparingNormal if the PreNormal hook is set, otherwise Normal.
+func NextLifecycleStateAfterCreated(lifecycle *Lifecycle) LifecycleStateType {
+	if lifecycle != nil && lifecycle.PreNormal != nil {
+		return LifecycleStatePreparingNormal
+	}
+	return LifecycleStateNormal
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pub
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ScalableWorkload is the common abstraction of workloads that manage a number of replicas,
+// so that tools can treat CloneSet, StatefulSet and others uniformly.
+type ScalableWorkload interface {
+	metav1.Object
+	// GetReplicas returns the desired replicas, which defaults to 1 if unspecified.
+	GetReplicas() int32
+	// SetReplicas sets the desired replicas.
+	SetReplicas(replicas int32)
+	// GetSelector returns the label selector over pods.
+	GetSelector() *metav1.LabelSelector
+	// GetStatusReplicas returns the number of pods created by the workload controller.
+	GetStatusReplicas() int32
+}
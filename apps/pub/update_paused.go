@@ -0,0 +1,54 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pub
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// StatefulSetUpdatePausedKey is the annotation on Pod that indicates the Pod should be skipped
+	// when its StatefulSet is rolling update, until the annotation is removed.
+	// The value of annotation should be "true".
+	StatefulSetUpdatePausedKey = "apps.kruise.io/statefulset-update-paused"
+)
+
+// IsPodUpdatePaused returns true if the update of the Pod has been paused.
+func IsPodUpdatePaused(obj metav1.Object) bool {
+	return obj.GetAnnotations()[StatefulSetUpdatePausedKey] == "true"
+}
+
+// SetPodUpdatePaused pauses the update of the Pod.
+func SetPodUpdatePaused(obj metav1.Object) {
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[StatefulSetUpdatePausedKey] = "true"
+	obj.SetAnnotations(annotations)
+}
+
+// ClearPodUpdatePaused resumes the update of the Pod.
+// GetAnnotations may return a copy, e.g. for Unstructured, so the annotations are always set back.
+func ClearPodUpdatePaused(obj metav1.Object) {
+	annotations := obj.GetAnnotations()
+	if _, ok := annotations[StatefulSetUpdatePausedKey]; !ok {
+		return
+	}
+	delete(annotations, StatefulSetUpdatePausedKey)
+	obj.SetAnnotations(annotations)
+}
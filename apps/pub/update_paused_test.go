@@ -0,0 +1,52 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pub
+
+import (
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestPodUpdatePaused(t *testing.T) {
+	cases := []struct {
+		name string
+		obj  metav1.Object
+	}{
+		{name: "pod", obj: &v1.Pod{}},
+		{name: "pod with annotations", obj: &v1.Pod{ObjectMeta: metav1.ObjectMeta{Annotations: map[string]string{"foo": "bar"}}}},
+		{name: "unstructured", obj: &unstructured.Unstructured{Object: map[string]interface{}{}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if IsPodUpdatePaused(tc.obj) {
+				t.Fatalf("expected not paused before SetPodUpdatePaused")
+			}
+			SetPodUpdatePaused(tc.obj)
+			if !IsPodUpdatePaused(tc.obj) {
+				t.Fatalf("expected paused after SetPodUpdatePaused")
+			}
+			ClearPodUpdatePaused(tc.obj)
+			if IsPodUpdatePaused(tc.obj) {
+				t.Fatalf("expected not paused after ClearPodUpdatePaused, got annotations %v", tc.obj.GetAnnotations())
+			}
+		})
+	}
+}
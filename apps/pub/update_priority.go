@@ -18,12 +18,20 @@ package pub
 
 import (
 	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
 
+	v1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
 )
 
 // UpdatePriorityStrategy is the strategy to define priority for pods update.
-// Only one of orderPriority and weightPriority can be set.
+// Only one of orderPriority and weightPriority can be set, while topologyPriority can work with either of them.
+// Pods are sorted by the sum of weightPriority first, then pods with the same weight are grouped by topologyPriority,
+// and orderPriority takes effect among the pods in the same topology.
 type UpdatePriorityStrategy struct {
 	// Order priority terms, pods will be sorted by the value of orderedKey.
 	// For example:
@@ -38,6 +46,17 @@ type UpdatePriorityStrategy struct {
 	OrderPriority []UpdatePriorityOrderTerm `json:"orderPriority,omitempty"`
 	// Weight priority terms, pods will be sorted by the sum of all terms weight.
 	WeightPriority []UpdatePriorityWeightTerm `json:"weightPriority,omitempty"`
+	// Topology priority terms, pods will be grouped by the topology of their nodes and updated group by group.
+	// For example, pods can be updated zone by zone with:
+	// ```
+	// topologyPriority:
+	// - topologyKey: topology.kubernetes.io/zone
+	//   preferredValues: [zone-a, zone-b]
+	// ```
+	TopologyPriority []UpdatePriorityTopologyTerm `json:"topologyPriority,omitempty"`
+	// ImagePulledPriority prefers pods on nodes where the images of the new revision have already been pulled,
+	// which are reported in the status of NodeImage. Its weight is added to the sum of weightPriority.
+	ImagePulledPriority *UpdatePriorityImagePulledTerm `json:"imagePulledPriority,omitempty"`
 }
 
 // UpdatePriorityOrder defines order priority.
@@ -56,6 +75,23 @@ type UpdatePriorityWeightTerm struct {
 	MatchSelector metav1.LabelSelector `json:"matchSelector"`
 }
 
+// UpdatePriorityImagePulledTerm defines image pulled priority.
+type UpdatePriorityImagePulledTerm struct {
+	// Weight associated with pods on nodes where the images have been pulled, in the range 1-100.
+	Weight int32 `json:"weight"`
+}
+
+// UpdatePriorityTopologyTerm defines topology priority.
+type UpdatePriorityTopologyTerm struct {
+	// TopologyKey is the key of node labels, such as topology.kubernetes.io/zone.
+	// Pods are grouped by the value of this key in the labels of their nodes.
+	TopologyKey string `json:"topologyKey"`
+	// PreferredValues are the topology values to be updated first, in order.
+	// Other values follow in lexical order, and pods whose node has no such label come last.
+	// +optional
+	PreferredValues []string `json:"preferredValues,omitempty"`
+}
+
 // FieldsValidation checks invalid fields in UpdatePriorityStrategy.
 func (strategy *UpdatePriorityStrategy) FieldsValidation() error {
 	if strategy == nil {
@@ -84,5 +120,158 @@ func (strategy *UpdatePriorityStrategy) FieldsValidation() error {
 		}
 	}
 
+	if strategy.ImagePulledPriority != nil {
+		if w := strategy.ImagePulledPriority.Weight; w < 1 || w > 100 {
+			return fmt.Errorf("weight must be valid number in the range 1-100")
+		}
+	}
+
+	for _, t := range strategy.TopologyPriority {
+		if len(t.TopologyKey) == 0 {
+			return fmt.Errorf("topology key can not be empty")
+		}
+	}
+
 	return nil
 }
+
+// PriorityOrderOptions contains the information of nodes used by ApplyPriorityOrder.
+type PriorityOrderOptions struct {
+	// NodeLabels maps node names to their labels. Topology terms are ignored if it is nil.
+	NodeLabels map[string]map[string]string
+	// ImagePulledNodes contains the names of nodes which have pulled the images of the new revision.
+	ImagePulledNodes map[string]bool
+}
+
+// ApplyPriorityOrder returns a copy of pods sorted by the priority strategy, pods with higher priority come first.
+// Weight terms are compared first, by the sum of weights of terms matching the pod labels plus the weight of
+// image pulled term if the node of pod is in opts.ImagePulledNodes. Then topology terms are compared with
+// opts.NodeLabels, and order terms are compared one by one, by the int suffix of the value of orderedKey.
+// Pods with the same priority are ordered by namespace and name, so the result is deterministic.
+// opts can be nil, then topology and image pulled terms are ignored since nodes are unknown.
+func ApplyPriorityOrder(strategy *UpdatePriorityStrategy, pods []*v1.Pod, opts *PriorityOrderOptions) []*v1.Pod {
+	sorted := make([]*v1.Pod, len(pods))
+	copy(sorted, pods)
+	if strategy == nil {
+		return sorted
+	}
+	if opts == nil {
+		opts = &PriorityOrderOptions{}
+	}
+
+	selectors := make([]labels.Selector, len(strategy.WeightPriority))
+	for i := range strategy.WeightPriority {
+		selector, err := metav1.LabelSelectorAsSelector(&strategy.WeightPriority[i].MatchSelector)
+		if err != nil {
+			selector = labels.Nothing()
+		}
+		selectors[i] = selector
+	}
+	weights := make(map[*v1.Pod]int64, len(sorted))
+	for _, pod := range sorted {
+		var weight int64
+		for i, selector := range selectors {
+			if selector.Matches(labels.Set(pod.Labels)) {
+				weight += int64(strategy.WeightPriority[i].Weight)
+			}
+		}
+		if strategy.ImagePulledPriority != nil && pod.Spec.NodeName != "" && opts.ImagePulledNodes[pod.Spec.NodeName] {
+			weight += int64(strategy.ImagePulledPriority.Weight)
+		}
+		weights[pod] = weight
+	}
+
+	sort.SliceStable(sorted, func(i, j int) bool {
+		podI, podJ := sorted[i], sorted[j]
+		if weights[podI] != weights[podJ] {
+			return weights[podI] > weights[podJ]
+		}
+		if opts.NodeLabels != nil {
+			if cmp := compareTopologyPriority(strategy.TopologyPriority, opts.NodeLabels[podI.Spec.NodeName], opts.NodeLabels[podJ.Spec.NodeName]); cmp != 0 {
+				return cmp > 0
+			}
+		}
+		if cmp := compareOrderPriority(strategy.OrderPriority, podI.Labels, podJ.Labels); cmp != 0 {
+			return cmp > 0
+		}
+		if podI.Namespace != podJ.Namespace {
+			return podI.Namespace < podJ.Namespace
+		}
+		return podI.Name < podJ.Name
+	})
+	return sorted
+}
+
+// compareOrderPriority returns positive if labelsI has higher priority, negative if labelsJ has higher priority.
+func compareOrderPriority(terms []UpdatePriorityOrderTerm, labelsI, labelsJ map[string]string) int {
+	for _, term := range terms {
+		valueI, okI := labelsI[term.OrderedKey]
+		valueJ, okJ := labelsJ[term.OrderedKey]
+		if !okI && !okJ {
+			continue
+		} else if !okJ {
+			return 1
+		} else if !okI {
+			return -1
+		}
+
+		intI, intJ := getIntFromStringSuffix(valueI), getIntFromStringSuffix(valueJ)
+		if intI != intJ {
+			if intI > intJ {
+				return 1
+			}
+			return -1
+		}
+		if valueI != valueJ {
+			return strings.Compare(valueI, valueJ)
+		}
+	}
+	return 0
+}
+
+// compareTopologyPriority returns positive if nodeLabelsI has higher priority, negative if nodeLabelsJ has higher priority.
+func compareTopologyPriority(terms []UpdatePriorityTopologyTerm, nodeLabelsI, nodeLabelsJ map[string]string) int {
+	for _, term := range terms {
+		valueI, okI := nodeLabelsI[term.TopologyKey]
+		valueJ, okJ := nodeLabelsJ[term.TopologyKey]
+		if !okI && !okJ {
+			continue
+		} else if !okJ {
+			return 1
+		} else if !okI {
+			return -1
+		}
+
+		rankI, rankJ := topologyValueRank(term.PreferredValues, valueI), topologyValueRank(term.PreferredValues, valueJ)
+		if rankI != rankJ {
+			if rankI < rankJ {
+				return 1
+			}
+			return -1
+		}
+		if valueI != valueJ {
+			return strings.Compare(valueJ, valueI)
+		}
+	}
+	return 0
+}
+
+// topologyValueRank returns the index of value in preferredValues, or len(preferredValues) if not found.
+func topologyValueRank(preferredValues []string, value string) int {
+	for i, v := range preferredValues {
+		if v == value {
+			return i
+		}
+	}
+	return len(preferredValues)
+}
+
+var intSuffixRegexp = regexp.MustCompile(`\d+$`)
+
+// getIntFromStringSuffix finds the last int in value, such as getting 5 in value '5', getting 10 in value 'sts-10'.
+func getIntFromStringSuffix(value string) int64 {
+	if i, err := strconv.ParseInt(intSuffixRegexp.FindString(value), 10, 64); err == nil {
+		return i
+	}
+	return 0
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pub
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestApplyPriorityOrder(t *testing.T) {
+	cases := []struct {
+		name     string
+		strategy *UpdatePriorityStrategy
+		pods     []*v1.Pod
+		opts     *PriorityOrderOptions
+		expected []string
+	}{
+		{
+			name: "nil strategy keeps the order",
+			pods: []*v1.Pod{
+				newPriorityTestPod("b", nil),
+				newPriorityTestPod("a", nil),
+			},
+			expected: []string{"b", "a"},
+		},
+		{
+			name: "weight terms with ties",
+			strategy: &UpdatePriorityStrategy{WeightPriority: []UpdatePriorityWeightTerm{
+				{Weight: 50, MatchSelector: metav1.LabelSelector{MatchLabels: map[string]string{"key": "foo"}}},
+				{Weight: 30, MatchSelector: metav1.LabelSelector{MatchLabels: map[string]string{"tier": "gold"}}},
+			}},
+			pods: []*v1.Pod{
+				newPriorityTestPod("e", nil),
+				newPriorityTestPod("d", map[string]string{"tier": "gold"}),
+				newPriorityTestPod("c", map[string]string{"key": "foo"}),
+				newPriorityTestPod("b", map[string]string{"key": "foo", "tier": "gold"}),
+				newPriorityTestPod("a", map[string]string{"key": "foo"}),
+			},
+			// a and c have the same weight, so they are ordered by name.
+			expected: []string{"b", "a", "c", "d", "e"},
+		},
+		{
+			name: "order terms with ties",
+			strategy: &UpdatePriorityStrategy{OrderPriority: []UpdatePriorityOrderTerm{
+				{OrderedKey: "key1"},
+				{OrderedKey: "key2"},
+			}},
+			pods: []*v1.Pod{
+				newPriorityTestPod("e", nil),
+				newPriorityTestPod("d", map[string]string{"key2": "sts-9"}),
+				newPriorityTestPod("c", map[string]string{"key1": "sts-10"}),
+				newPriorityTestPod("b", map[string]string{"key1": "5"}),
+				newPriorityTestPod("a", map[string]string{"key1": "5"}),
+			},
+			// a and b have the same value of key1, so they are ordered by name.
+			expected: []string{"c", "a", "b", "d", "e"},
+		},
+		{
+			name: "topology terms with node labels",
+			strategy: &UpdatePriorityStrategy{TopologyPriority: []UpdatePriorityTopologyTerm{
+				{TopologyKey: "zone", PreferredValues: []string{"zone-b"}},
+			}},
+			pods: []*v1.Pod{
+				newPriorityTestPodOnNode("d", "node-none"),
+				newPriorityTestPodOnNode("c", "node-a"),
+				newPriorityTestPodOnNode("b", "node-b"),
+				newPriorityTestPodOnNode("a", "node-c"),
+			},
+			opts: &PriorityOrderOptions{NodeLabels: map[string]map[string]string{
+				"node-a": {"zone": "zone-a"},
+				"node-b": {"zone": "zone-b"},
+				"node-c": {"zone": "zone-c"},
+			}},
+			// zone-b is preferred, other zones follow in lexical order and pods without zone come last.
+			expected: []string{"b", "c", "a", "d"},
+		},
+		{
+			name: "topology terms without node labels",
+			strategy: &UpdatePriorityStrategy{TopologyPriority: []UpdatePriorityTopologyTerm{
+				{TopologyKey: "zone"},
+			}},
+			pods: []*v1.Pod{
+				newPriorityTestPodOnNode("b", "node-a"),
+				newPriorityTestPodOnNode("a", "node-b"),
+			},
+			expected: []string{"a", "b"},
+		},
+		{
+			name: "image pulled term adds to weight",
+			strategy: &UpdatePriorityStrategy{
+				WeightPriority: []UpdatePriorityWeightTerm{
+					{Weight: 30, MatchSelector: metav1.LabelSelector{MatchLabels: map[string]string{"key": "foo"}}},
+				},
+				ImagePulledPriority: &UpdatePriorityImagePulledTerm{Weight: 50},
+			},
+			pods: []*v1.Pod{
+				newPriorityTestPodOnNode("c", "node-a"),
+				newPriorityTestPod("b", map[string]string{"key": "foo"}),
+				newPriorityTestPodOnNode("a", "node-b"),
+			},
+			opts:     &PriorityOrderOptions{ImagePulledNodes: map[string]bool{"node-a": true}},
+			expected: []string{"c", "b", "a"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			input := make([]*v1.Pod, len(tc.pods))
+			copy(input, tc.pods)
+
+			sorted := ApplyPriorityOrder(tc.strategy, tc.pods, tc.opts)
+			var got []string
+			for _, pod := range sorted {
+				got = append(got, pod.Name)
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+			if !reflect.DeepEqual(input, tc.pods) {
+				t.Fatalf("expected the input pods not to be reordered")
+			}
+		})
+	}
+}
+
+func TestUpdatePriorityStrategyFieldsValidation(t *testing.T) {
+	selector := metav1.LabelSelector{MatchLabels: map[string]string{"key": "foo"}}
+	cases := []struct {
+		name        string
+		strategy    *UpdatePriorityStrategy
+		expectError string
+	}{
+		{name: "nil strategy"},
+		{
+			name:     "valid weight and image pulled terms",
+			strategy: &UpdatePriorityStrategy{WeightPriority: []UpdatePriorityWeightTerm{{Weight: 10, MatchSelector: selector}}, ImagePulledPriority: &UpdatePriorityImagePulledTerm{Weight: 1}},
+		},
+		{
+			name:        "both weight and order terms",
+			strategy:    &UpdatePriorityStrategy{WeightPriority: []UpdatePriorityWeightTerm{{Weight: 10, MatchSelector: selector}}, OrderPriority: []UpdatePriorityOrderTerm{{OrderedKey: "key"}}},
+			expectError: "only one of weightPriority and orderPriority can be used",
+		},
+		{
+			name:        "image pulled weight 0",
+			strategy:    &UpdatePriorityStrategy{ImagePulledPriority: &UpdatePriorityImagePulledTerm{Weight: 0}},
+			expectError: "weight must be valid number in the range 1-100",
+		},
+		{
+			name:        "image pulled weight over 100",
+			strategy:    &UpdatePriorityStrategy{ImagePulledPriority: &UpdatePriorityImagePulledTerm{Weight: 101}},
+			expectError: "weight must be valid number in the range 1-100",
+		},
+		{
+			name:        "empty topology key",
+			strategy:    &UpdatePriorityStrategy{TopologyPriority: []UpdatePriorityTopologyTerm{{}}},
+			expectError: "topology key can not be empty",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.strategy.FieldsValidation()
+			if tc.expectError == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || err.Error() != tc.expectError {
+				t.Fatalf("expected error %q, got %v", tc.expectError, err)
+			}
+		})
+	}
+}
+
+func newPriorityTestPod(name string, labels map[string]string) *v1.Pod {
+	return &v1.Pod{ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: name, Labels: labels}}
+}
+
+func newPriorityTestPodOnNode(name, nodeName string) *v1.Pod {
+	pod := newPriorityTestPod(name, nil)
+	pod.Spec.NodeName = nodeName
+	return pod
+}
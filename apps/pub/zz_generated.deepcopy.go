@@ -63,6 +63,11 @@ func (in *InPlaceUpdateState) DeepCopy() *InPlaceUpdateState {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InPlaceUpdateStrategy) DeepCopyInto(out *InPlaceUpdateStrategy) {
 	*out = *in
+	if in.Features != nil {
+		in, out := &in.Features, &out.Features
+		*out = make([]InPlaceUpdateFeature, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InPlaceUpdateStrategy.
@@ -78,6 +83,11 @@ func (in *InPlaceUpdateStrategy) DeepCopy() *InPlaceUpdateStrategy {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Lifecycle) DeepCopyInto(out *Lifecycle) {
 	*out = *in
+	if in.PreNormal != nil {
+		in, out := &in.PreNormal, &out.PreNormal
+		*out = new(LifecycleHook)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.PreDelete != nil {
 		in, out := &in.PreDelete, &out.PreDelete
 		*out = new(LifecycleHook)
@@ -127,6 +137,21 @@ func (in *LifecycleHook) DeepCopy() *LifecycleHook {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdatePriorityImagePulledTerm) DeepCopyInto(out *UpdatePriorityImagePulledTerm) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdatePriorityImagePulledTerm.
+func (in *UpdatePriorityImagePulledTerm) DeepCopy() *UpdatePriorityImagePulledTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdatePriorityImagePulledTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpdatePriorityOrderTerm) DeepCopyInto(out *UpdatePriorityOrderTerm) {
 	*out = *in
@@ -157,6 +182,18 @@ func (in *UpdatePriorityStrategy) DeepCopyInto(out *UpdatePriorityStrategy) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.TopologyPriority != nil {
+		in, out := &in.TopologyPriority, &out.TopologyPriority
+		*out = make([]UpdatePriorityTopologyTerm, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.ImagePulledPriority != nil {
+		in, out := &in.ImagePulledPriority, &out.ImagePulledPriority
+		*out = new(UpdatePriorityImagePulledTerm)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdatePriorityStrategy.
@@ -169,6 +206,26 @@ func (in *UpdatePriorityStrategy) DeepCopy() *UpdatePriorityStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *UpdatePriorityTopologyTerm) DeepCopyInto(out *UpdatePriorityTopologyTerm) {
+	*out = *in
+	if in.PreferredValues != nil {
+		in, out := &in.PreferredValues, &out.PreferredValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new UpdatePriorityTopologyTerm.
+func (in *UpdatePriorityTopologyTerm) DeepCopy() *UpdatePriorityTopologyTerm {
+	if in == nil {
+		return nil
+	}
+	out := new(UpdatePriorityTopologyTerm)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UpdatePriorityWeightTerm) DeepCopyInto(out *UpdatePriorityWeightTerm) {
 	*out = *in
@@ -0,0 +1,74 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// GetTemplateKind returns the kind of the template that is set, or empty if none of them is set.
+// If more than one template is set, the first one in the order of Job, BroadcastJob and CloneSetScale is returned.
+func (t *CronJobTemplate) GetTemplateKind() TemplateKind {
+	switch {
+	case t.JobTemplate != nil:
+		return JobTemplate
+	case t.BroadcastJobTemplate != nil:
+		return BroadcastJobTemplate
+	case t.CloneSetScaleTemplate != nil:
+		return CloneSetScaleTemplate
+	}
+	return ""
+}
+
+// IsSuspended returns true if the cron job is paused, or it is suspended until a time later than now.
+func (acj *AdvancedCronJob) IsSuspended(now time.Time) bool {
+	if acj.Spec.Paused != nil && *acj.Spec.Paused {
+		return true
+	}
+	return acj.Spec.SuspendUntil != nil && now.Before(acj.Spec.SuspendUntil.Time)
+}
+
+// RecordAdvancedCronJobRun appends a finished run into status.recentRuns, drops the oldest runs beyond
+// MaxAdvancedCronJobRecentRuns, and updates lastSuccessfulTime or lastFailedTime with the finished time.
+func RecordAdvancedCronJobRun(status *AdvancedCronJobStatus, run AdvancedCronJobRun, finishedTime metav1.Time) {
+	switch run.Result {
+	case AdvancedCronJobRunSucceeded:
+		status.LastSuccessfulTime = &finishedTime
+	case AdvancedCronJobRunFailed:
+		status.LastFailedTime = &finishedTime
+	}
+
+	status.RecentRuns = append(status.RecentRuns, run)
+	if overflow := len(status.RecentRuns) - MaxAdvancedCronJobRecentRuns; overflow > 0 {
+		status.RecentRuns = append([]AdvancedCronJobRun{}, status.RecentRuns[overflow:]...)
+	}
+}
+
+// ShouldNotify returns true if the event should be notified, which means the events list is empty or contains it.
+func (n *AdvancedCronJobNotification) ShouldNotify(event AdvancedCronJobNotificationEvent) bool {
+	if len(n.Events) == 0 {
+		return true
+	}
+	for _, e := range n.Events {
+		if e == event {
+			return true
+		}
+	}
+	return false
+}
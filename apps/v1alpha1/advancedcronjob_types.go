@@ -35,14 +35,26 @@ type AdvancedCronJobSpec struct {
 
 	// Optional deadline in seconds for starting the job if it misses scheduled
 	// time for any reason.  Missed jobs executions will be counted as failed ones.
+	// Only the missed schedules within the deadline are considered by missedRunPolicy.
 	// +optional
 	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty" protobuf:"varint,2,opt,name=startingDeadlineSeconds"`
 
+	// Specifies how to treat the schedules missed for any reason, such as the controller being down
+	// or the cron job being paused. Valid values are:
+	// - "SkipAll": skips all the missed schedules and waits for the next one;
+	// - "RunOnce" (default): runs once for the latest missed schedule;
+	// - "CatchUpAll": runs once for each of the missed schedules in order.
+	// If startingDeadlineSeconds is set, the schedules missed longer than it are always skipped.
+	// +optional
+	MissedRunPolicy MissedRunPolicyType `json:"missedRunPolicy,omitempty" protobuf:"bytes,10,opt,name=missedRunPolicy"`
+
 	// Specifies how to treat concurrent executions of a Job.
 	// Valid values are:
-	// - "Allow" (default): allows CronJobs to run concurrently;
+	// - "Allow": allows CronJobs to run concurrently;
 	// - "Forbid": forbids concurrent runs, skipping next run if previous run hasn't finished yet;
 	// - "Replace": cancels currently running job and replaces it with a new one
+	// Defaults to "Forbid" for broadcastJobTemplate, since each run occupies all the selected nodes,
+	// and "Allow" for the other templates.
 	// +optional
 	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty" protobuf:"bytes,3,opt,name=concurrencyPolicy"`
 
@@ -50,6 +62,15 @@ type AdvancedCronJobSpec struct {
 	// +optional
 	Paused *bool `json:"paused,omitempty" protobuf:"bytes,4,opt,name=paused"`
 
+	// SuspendUntil pauses the cron job until the given time, and it will resume automatically after that.
+	// It takes no effect if paused is true.
+	// +optional
+	SuspendUntil *metav1.Time `json:"suspendUntil,omitempty" protobuf:"bytes,9,opt,name=suspendUntil"`
+
+	// Notification pushes the events of finished runs to a webhook.
+	// +optional
+	Notification *AdvancedCronJobNotification `json:"notification,omitempty" protobuf:"bytes,11,opt,name=notification"`
+
 	// +kubebuilder:validation:Minimum=0
 
 	// The number of successful finished jobs to retain.
@@ -66,6 +87,11 @@ type AdvancedCronJobSpec struct {
 
 	// Specifies the job that will be created when executing a CronJob.
 	Template CronJobTemplate `json:"template" protobuf:"bytes,7,opt,name=template"`
+
+	// The time zone name for the given schedule, see https://en.wikipedia.org/wiki/List_of_tz_database_time_zones.
+	// If not specified, this will default to the time zone of the kruise-controller-manager process.
+	// +optional
+	TimeZone *string `json:"timeZone,omitempty" protobuf:"bytes,8,opt,name=timeZone"`
 }
 
 type CronJobTemplate struct {
@@ -76,6 +102,10 @@ type CronJobTemplate struct {
 	// Specifies the broadcastjob that will be created when executing a BroadcastCronJob.
 	// +optional
 	BroadcastJobTemplate *BroadcastJobTemplateSpec `json:"broadcastJobTemplate,omitempty" protobuf:"bytes,2,opt,name=broadcastJobTemplate"`
+
+	// Specifies the scaling of a CloneSet that will be performed when executing a CloneSetScaleCronJob.
+	// +optional
+	CloneSetScaleTemplate *CloneSetScaleTemplateSpec `json:"cloneSetScaleTemplate,omitempty" protobuf:"bytes,3,opt,name=cloneSetScaleTemplate"`
 }
 
 type TemplateKind string
@@ -84,6 +114,8 @@ const (
 	JobTemplate TemplateKind = "Job"
 
 	BroadcastJobTemplate TemplateKind = "BroadcastJob"
+
+	CloneSetScaleTemplate TemplateKind = "CloneSetScale"
 )
 
 // JobTemplateSpec describes the data a Job should have when created from a template
@@ -97,10 +129,21 @@ type BroadcastJobTemplateSpec struct {
 	Spec BroadcastJobSpec `json:"spec,omitempty" protobuf:"bytes,2,opt,name=spec"`
 }
 
+// CloneSetScaleTemplateSpec describes how a CloneSet should be scaled when a scheduled time comes.
+type CloneSetScaleTemplateSpec struct {
+	// Name of the CloneSet in the same namespace to be scaled.
+	CloneSetName string `json:"cloneSetName" protobuf:"bytes,1,opt,name=cloneSetName"`
+
+	// +kubebuilder:validation:Minimum=0
+
+	// Replicas is the desired number of replicas that the CloneSet will be scaled to.
+	Replicas int32 `json:"replicas" protobuf:"varint,2,opt,name=replicas"`
+}
+
 // ConcurrencyPolicy describes how the job will be handled.
 // Only one of the following concurrent policies may be specified.
 // If none of the following policies is specified, the default one
-// is AllowConcurrent.
+// is ForbidConcurrent for BroadcastJob template and AllowConcurrent for others.
 // +kubebuilder:validation:Enum=Allow;Forbid;Replace
 type ConcurrencyPolicy string
 
@@ -116,6 +159,48 @@ const (
 	ReplaceConcurrent ConcurrencyPolicy = "Replace"
 )
 
+// AdvancedCronJobNotification describes the webhook to be called when runs finish.
+type AdvancedCronJobNotification struct {
+	// URL of the webhook, which must use http or https scheme.
+	URL string `json:"url" protobuf:"bytes,1,opt,name=url"`
+
+	// HeadersSecretRef refers to a secret in the same namespace, whose data will be sent as
+	// HTTP headers of the request, such as Authorization.
+	// +optional
+	HeadersSecretRef *corev1.LocalObjectReference `json:"headersSecretRef,omitempty" protobuf:"bytes,2,opt,name=headersSecretRef"`
+
+	// Events to be notified. If empty, all the events will be notified.
+	// +optional
+	Events []AdvancedCronJobNotificationEvent `json:"events,omitempty" protobuf:"bytes,3,rep,name=events"`
+}
+
+// AdvancedCronJobNotificationEvent is the event of a run that can be notified.
+// +kubebuilder:validation:Enum=Succeeded;Failed
+type AdvancedCronJobNotificationEvent string
+
+const (
+	// AdvancedCronJobNotificationEventSucceeded is notified when a run completes successfully.
+	AdvancedCronJobNotificationEventSucceeded AdvancedCronJobNotificationEvent = "Succeeded"
+
+	// AdvancedCronJobNotificationEventFailed is notified when a run fails.
+	AdvancedCronJobNotificationEventFailed AdvancedCronJobNotificationEvent = "Failed"
+)
+
+// MissedRunPolicyType describes how the missed schedules will be handled.
+// +kubebuilder:validation:Enum=SkipAll;RunOnce;CatchUpAll
+type MissedRunPolicyType string
+
+const (
+	// SkipAllMissedRunPolicyType skips all the missed schedules.
+	SkipAllMissedRunPolicyType MissedRunPolicyType = "SkipAll"
+
+	// RunOnceMissedRunPolicyType runs once for the latest missed schedule.
+	RunOnceMissedRunPolicyType MissedRunPolicyType = "RunOnce"
+
+	// CatchUpAllMissedRunPolicyType runs once for each of the missed schedules.
+	CatchUpAllMissedRunPolicyType MissedRunPolicyType = "CatchUpAll"
+)
+
 // AdvancedCronJobStatus defines the observed state of AdvancedCronJob
 type AdvancedCronJobStatus struct {
 	Type TemplateKind `json:"type,omitempty"`
@@ -127,6 +212,79 @@ type AdvancedCronJobStatus struct {
 	// Information when was the last time the job was successfully scheduled.
 	// +optional
 	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
+	// Information when was the last time the job successfully completed.
+	// +optional
+	LastSuccessfulTime *metav1.Time `json:"lastSuccessfulTime,omitempty"`
+
+	// Information when was the last time the job failed.
+	// +optional
+	LastFailedTime *metav1.Time `json:"lastFailedTime,omitempty"`
+
+	// +kubebuilder:validation:MaxItems=10
+
+	// RecentRuns records the most recent finished runs, newest last.
+	// It keeps at most MaxAdvancedCronJobRecentRuns items.
+	// +optional
+	RecentRuns []AdvancedCronJobRun `json:"recentRuns,omitempty"`
+
+	// Represents the latest available observations of an AdvancedCronJob's current state.
+	// +optional
+	Conditions []AdvancedCronJobCondition `json:"conditions,omitempty"`
+}
+
+// AdvancedCronJobConditionType is type for AdvancedCronJob conditions.
+type AdvancedCronJobConditionType string
+
+const (
+	// AdvancedCronJobConditionScheduleError indicates the schedule or time zone of AdvancedCronJob can not be parsed.
+	AdvancedCronJobConditionScheduleError AdvancedCronJobConditionType = "ScheduleError"
+	// AdvancedCronJobConditionChildCreateFailed indicates advancedcronjob controller failed to create the job,
+	// broadcastjob or scaling of the template.
+	AdvancedCronJobConditionChildCreateFailed AdvancedCronJobConditionType = "ChildCreateFailed"
+	// AdvancedCronJobConditionPaused indicates AdvancedCronJob is paused or suspended until a later time.
+	AdvancedCronJobConditionPaused AdvancedCronJobConditionType = "Paused"
+)
+
+// AdvancedCronJobCondition describes the state of an AdvancedCronJob at a certain point.
+type AdvancedCronJobCondition struct {
+	// Type of AdvancedCronJob condition.
+	Type AdvancedCronJobConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status corev1.ConditionStatus `json:"status"`
+	// Last time the condition transitioned from one status to another.
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// The reason for the condition's last transition.
+	Reason string `json:"reason,omitempty"`
+	// A human readable message indicating details about the transition.
+	Message string `json:"message,omitempty"`
+}
+
+// MaxAdvancedCronJobRecentRuns is the max number of runs kept in status.recentRuns.
+const MaxAdvancedCronJobRecentRuns = 10
+
+// AdvancedCronJobRunResult is the result of a finished run.
+type AdvancedCronJobRunResult string
+
+const (
+	// AdvancedCronJobRunSucceeded means the job created by the run completed successfully.
+	AdvancedCronJobRunSucceeded AdvancedCronJobRunResult = "Succeeded"
+
+	// AdvancedCronJobRunFailed means the job created by the run failed.
+	AdvancedCronJobRunFailed AdvancedCronJobRunResult = "Failed"
+)
+
+// AdvancedCronJobRun describes a finished run of AdvancedCronJob.
+type AdvancedCronJobRun struct {
+	// Name of the job created by the run.
+	Name string `json:"name"`
+
+	// Result of the run.
+	Result AdvancedCronJobRunResult `json:"result"`
+
+	// Duration from the job was created to it finished.
+	// +optional
+	Duration metav1.Duration `json:"duration,omitempty"`
 }
 
 // +genclient
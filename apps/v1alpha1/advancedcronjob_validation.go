@@ -0,0 +1,178 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// validTimeZoneCharacters matches each part of a tz database name, such as "America" and "New_York".
+var validTimeZoneCharacters = regexp.MustCompile(`^[A-Za-z\.\-_0-9+]{1,14}$`)
+
+// ValidateCronJobTemplate checks exactly one of jobTemplate, broadcastJobTemplate and cloneSetScaleTemplate is set,
+// and the cloneSetScaleTemplate is valid if it is set.
+func ValidateCronJobTemplate(spec *AdvancedCronJobSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil {
+		return allErrs
+	}
+
+	template := &spec.Template
+	templatePath := fldPath.Child("template")
+	var setCount int
+	if template.JobTemplate != nil {
+		setCount++
+	}
+	if template.BroadcastJobTemplate != nil {
+		setCount++
+	}
+	if template.CloneSetScaleTemplate != nil {
+		setCount++
+	}
+	switch {
+	case setCount == 0:
+		allErrs = append(allErrs, field.Required(templatePath, "one of jobTemplate, broadcastJobTemplate or cloneSetScaleTemplate must be specified"))
+	case setCount > 1:
+		allErrs = append(allErrs, field.Forbidden(templatePath, "only one of jobTemplate, broadcastJobTemplate or cloneSetScaleTemplate may be specified"))
+	}
+
+	if scaleTemplate := template.CloneSetScaleTemplate; scaleTemplate != nil {
+		scalePath := templatePath.Child("cloneSetScaleTemplate")
+		if scaleTemplate.CloneSetName == "" {
+			allErrs = append(allErrs, field.Required(scalePath.Child("cloneSetName"), ""))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(scaleTemplate.CloneSetName) {
+				allErrs = append(allErrs, field.Invalid(scalePath.Child("cloneSetName"), scaleTemplate.CloneSetName, msg))
+			}
+		}
+		if scaleTemplate.Replicas < 0 {
+			allErrs = append(allErrs, field.Invalid(scalePath.Child("replicas"), scaleTemplate.Replicas, "must be greater than or equal to 0"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateAdvancedCronJobTimeZone checks timeZone is a name in the format of the tz database, and the schedule
+// does not specify its own time zone with TZ or CRON_TZ if timeZone is set.
+// Whether the time zone exists is left to the controller, as it depends on the tz database it runs with.
+func ValidateAdvancedCronJobTimeZone(spec *AdvancedCronJobSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.TimeZone == nil {
+		return allErrs
+	}
+
+	timeZonePath := fldPath.Child("timeZone")
+	timeZone := *spec.TimeZone
+	if timeZone == "" {
+		return append(allErrs, field.Invalid(timeZonePath, timeZone, "must be nil or a non-empty string"))
+	}
+	if strings.EqualFold(timeZone, "Local") {
+		allErrs = append(allErrs, field.Invalid(timeZonePath, timeZone, "must be an explicit time zone as defined in https://www.iana.org/time-zones"))
+	}
+	for _, part := range strings.Split(timeZone, "/") {
+		if part == "." || part == ".." || strings.HasPrefix(part, "-") || !validTimeZoneCharacters.MatchString(part) {
+			allErrs = append(allErrs, field.Invalid(timeZonePath, timeZone, "unknown time zone"))
+			break
+		}
+	}
+	if strings.Contains(spec.Schedule, "TZ") {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("schedule"), spec.Schedule, "cannot use TZ or CRON_TZ in schedule, use timeZone field instead"))
+	}
+	return allErrs
+}
+
+// ValidateAdvancedCronJobSuspendUntil checks suspendUntil is in the future when the cron job is created.
+// It should not be called on update, since a suspendUntil in the past just means the cron job has resumed.
+func ValidateAdvancedCronJobSuspendUntil(spec *AdvancedCronJobSpec, fldPath *field.Path, now time.Time) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.SuspendUntil == nil {
+		return allErrs
+	}
+
+	if !spec.SuspendUntil.Time.After(now) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("suspendUntil"), spec.SuspendUntil.Time, "must be in the future"))
+	}
+	return allErrs
+}
+
+// ValidateAdvancedCronJobMissedRunPolicy checks the type of missedRunPolicy.
+func ValidateAdvancedCronJobMissedRunPolicy(spec *AdvancedCronJobSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil {
+		return allErrs
+	}
+
+	switch spec.MissedRunPolicy {
+	case "", SkipAllMissedRunPolicyType, RunOnceMissedRunPolicyType, CatchUpAllMissedRunPolicyType:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("missedRunPolicy"), spec.MissedRunPolicy,
+			[]string{string(SkipAllMissedRunPolicyType), string(RunOnceMissedRunPolicyType), string(CatchUpAllMissedRunPolicyType)}))
+	}
+	return allErrs
+}
+
+// ValidateAdvancedCronJobNotification checks the url of notification is an absolute http or https URL,
+// the name of headersSecretRef is valid, and the events are supported and not duplicated.
+func ValidateAdvancedCronJobNotification(spec *AdvancedCronJobSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.Notification == nil {
+		return allErrs
+	}
+
+	notification := spec.Notification
+	notificationPath := fldPath.Child("notification")
+	if notification.URL == "" {
+		allErrs = append(allErrs, field.Required(notificationPath.Child("url"), ""))
+	} else if u, err := url.Parse(notification.URL); err != nil {
+		allErrs = append(allErrs, field.Invalid(notificationPath.Child("url"), notification.URL, err.Error()))
+	} else if (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		allErrs = append(allErrs, field.Invalid(notificationPath.Child("url"), notification.URL, "must be an absolute URL with http or https scheme"))
+	}
+
+	if ref := notification.HeadersSecretRef; ref != nil {
+		if ref.Name == "" {
+			allErrs = append(allErrs, field.Required(notificationPath.Child("headersSecretRef", "name"), ""))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(ref.Name) {
+				allErrs = append(allErrs, field.Invalid(notificationPath.Child("headersSecretRef", "name"), ref.Name, msg))
+			}
+		}
+	}
+
+	events := sets.NewString()
+	for i, event := range notification.Events {
+		switch event {
+		case AdvancedCronJobNotificationEventSucceeded, AdvancedCronJobNotificationEventFailed:
+		default:
+			allErrs = append(allErrs, field.NotSupported(notificationPath.Child("events").Index(i), event,
+				[]string{string(AdvancedCronJobNotificationEventSucceeded), string(AdvancedCronJobNotificationEventFailed)}))
+			continue
+		}
+		if events.Has(string(event)) {
+			allErrs = append(allErrs, field.Duplicate(notificationPath.Child("events").Index(i), event))
+		}
+		events.Insert(string(event))
+	}
+	return allErrs
+}
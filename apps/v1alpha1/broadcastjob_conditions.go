@@ -0,0 +1,96 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewBroadcastJobCondition creates a new BroadcastJob condition.
+func NewBroadcastJobCondition(condType JobConditionType, status v1.ConditionStatus, reason, message string) JobCondition {
+	now := metav1.Now()
+	return JobCondition{
+		Type:               condType,
+		Status:             status,
+		LastProbeTime:      now,
+		LastTransitionTime: now,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// FindBroadcastJobCondition returns the condition with the provided type in status, which can be modified
+// in place, or nil if not found.
+func FindBroadcastJobCondition(status *BroadcastJobStatus, condType JobConditionType) *JobCondition {
+	for i := range status.Conditions {
+		if status.Conditions[i].Type == condType {
+			return &status.Conditions[i]
+		}
+	}
+	return nil
+}
+
+// GetBroadcastJobCondition returns a copy of the condition with the provided type.
+func GetBroadcastJobCondition(status BroadcastJobStatus, condType JobConditionType) *JobCondition {
+	if c := FindBroadcastJobCondition(&status, condType); c != nil {
+		cond := *c
+		return &cond
+	}
+	return nil
+}
+
+// SetBroadcastJobCondition updates the BroadcastJob to include the provided condition. If the condition that
+// we are about to add already exists and has the same status and reason, only lastProbeTime is updated.
+func SetBroadcastJobCondition(status *BroadcastJobStatus, condition JobCondition) {
+	currentCond := FindBroadcastJobCondition(status, condition.Type)
+	if currentCond == nil {
+		status.Conditions = append(status.Conditions, condition)
+		return
+	}
+	if currentCond.Status == condition.Status && currentCond.Reason == condition.Reason {
+		currentCond.LastProbeTime = condition.LastProbeTime
+		return
+	}
+	// Do not update lastTransitionTime if the status of the condition doesn't change.
+	if currentCond.Status == condition.Status {
+		condition.LastTransitionTime = currentCond.LastTransitionTime
+	}
+	*currentCond = condition
+}
+
+// RemoveBroadcastJobCondition removes the BroadcastJob condition with the provided type.
+func RemoveBroadcastJobCondition(status *BroadcastJobStatus, condType JobConditionType) {
+	var newConditions []JobCondition
+	for _, c := range status.Conditions {
+		if c.Type == condType {
+			continue
+		}
+		newConditions = append(newConditions, c)
+	}
+	status.Conditions = newConditions
+}
+
+// IsBroadcastJobFinished returns true if the job has a true Complete or Failed condition.
+func IsBroadcastJobFinished(status *BroadcastJobStatus) bool {
+	for _, c := range status.Conditions {
+		if (c.Type == JobComplete || c.Type == JobFailed) && c.Status == v1.ConditionTrue {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,88 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// GetFailedNodeNames returns the names of the nodes whose pods have failed.
+func (job *BroadcastJob) GetFailedNodeNames() []string {
+	var names []string
+	for _, s := range job.Status.NodeStatuses {
+		if s.Phase == v1.PodFailed {
+			names = append(names, s.NodeName)
+		}
+	}
+	return names
+}
+
+// ResolveParallelism returns the maximum number of pods the job should run at the same time, given the number
+// of desired nodes. Parallelism in percentage is calculated from desired by rounding up, and desired is returned
+// if parallelism is not specified, which means no limit.
+func (job *BroadcastJob) ResolveParallelism(desired int32) (int32, error) {
+	if job.Spec.Parallelism == nil {
+		return desired, nil
+	}
+	parallelism, err := intstr.GetValueFromIntOrPercent(job.Spec.Parallelism, int(desired), true)
+	if err != nil {
+		return 0, err
+	}
+	if parallelism < 0 {
+		parallelism = 0
+	}
+	return int32(parallelism), nil
+}
+
+// IsNodeSelected returns true if the node is selected by spec.nodeSelection, or nodeSelection is not specified.
+// The node selector and affinity of pod template are not checked here.
+func (job *BroadcastJob) IsNodeSelected(node *v1.Node) (bool, error) {
+	selection := job.Spec.NodeSelection
+	if selection == nil {
+		return true, nil
+	}
+	for _, name := range selection.ExcludeNames {
+		if name == node.Name {
+			return false, nil
+		}
+	}
+	if len(selection.Names) > 0 {
+		found := false
+		for _, name := range selection.Names {
+			if name == node.Name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false, nil
+		}
+	}
+	if selection.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(selection.LabelSelector)
+		if err != nil {
+			return false, err
+		}
+		if !selector.Matches(labels.Set(node.Labels)) {
+			return false, nil
+		}
+	}
+	return true, nil
+}
@@ -39,13 +39,39 @@ type BroadcastJobSpec struct {
 	// +optional
 	CompletionPolicy CompletionPolicy `json:"completionPolicy" protobuf:"bytes,3,opt,name=completionPolicy"`
 
-	// Paused will pause the job.
+	// Paused will pause the job, which means no more pods will be created, and the job can be resumed
+	// by setting it to false. The running pods are not affected.
 	// +optional
 	Paused bool `json:"paused,omitempty" protobuf:"bytes,4,opt,name=paused"`
 
 	// FailurePolicy indicates the behavior of the job, when failed pod is found.
 	// +optional
 	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty" protobuf:"bytes,5,opt,name=failurePolicy"`
+
+	// PodActiveDeadlineSeconds specifies the duration in seconds relative to the startTime of each pod that
+	// the pod may be active before it is terminated and considered failed with PodDeadlineExceeded reason;
+	// value must be positive integer. It differs from completionPolicy.activeDeadlineSeconds, which bounds
+	// the whole job.
+	// +optional
+	PodActiveDeadlineSeconds *int64 `json:"podActiveDeadlineSeconds,omitempty" protobuf:"varint,6,opt,name=podActiveDeadlineSeconds"`
+
+	// NodeSelection explicitly selects the nodes to run pods, in addition to the node selector and affinity
+	// of pod template. A node is selected only if it matches all of the specified rules.
+	// +optional
+	NodeSelection *BroadcastJobNodeSelection `json:"nodeSelection,omitempty" protobuf:"bytes,7,opt,name=nodeSelection"`
+}
+
+// BroadcastJobNodeSelection defines the nodes that the job should run pods on.
+type BroadcastJobNodeSelection struct {
+	// LabelSelector is a label query over nodes.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty" protobuf:"bytes,1,opt,name=labelSelector"`
+	// Names of the nodes to run pods on. If specified, only the listed nodes are selected.
+	// +optional
+	Names []string `json:"names,omitempty" protobuf:"bytes,2,rep,name=names"`
+	// ExcludeNames are the names of nodes that should not run pods, which takes precedence over the other rules.
+	// +optional
+	ExcludeNames []string `json:"excludeNames,omitempty" protobuf:"bytes,3,rep,name=excludeNames"`
 }
 
 // CompletionPolicy indicates the completion policy for the job
@@ -129,6 +155,25 @@ type BroadcastJobStatus struct {
 	// The phase of the job.
 	// +optional
 	Phase BroadcastJobPhase `json:"phase" protobuf:"varint,8,opt,name=phase"`
+
+	// NodeStatuses records the results of pods on the desired nodes. To bound the size of status,
+	// the nodes whose pods have succeeded are not recorded.
+	// +optional
+	NodeStatuses []BroadcastJobNodeStatus `json:"nodeStatuses,omitempty" protobuf:"bytes,9,rep,name=nodeStatuses"`
+}
+
+// BroadcastJobNodeStatus records the result of the pod on a node.
+type BroadcastJobNodeStatus struct {
+	// NodeName is the name of the node.
+	NodeName string `json:"nodeName" protobuf:"bytes,1,opt,name=nodeName"`
+	// Phase is the phase of the pod on the node.
+	Phase v1.PodPhase `json:"phase" protobuf:"bytes,2,opt,name=phase,casttype=k8s.io/api/core/v1.PodPhase"`
+	// RestartCount is the total restart count of the containers in the pod.
+	// +optional
+	RestartCount int32 `json:"restartCount,omitempty" protobuf:"varint,3,opt,name=restartCount"`
+	// Message indicating details about why the pod is in this phase, such as PodDeadlineExceeded.
+	// +optional
+	Message string `json:"message,omitempty" protobuf:"bytes,4,opt,name=message"`
 }
 
 // BroadcastJobPhase indicates the phase of the job.
@@ -185,6 +230,20 @@ const (
 	// JobFailed means the job has failed its execution. A failed job means the job has either exceeded the
 	// ActiveDeadlineSeconds limit, or the aggregated number of container restarts for all pods have exceeded the BackoffLimit.
 	JobFailed JobConditionType = "Failed"
+
+	// JobPaused means the job is paused by spec.paused, or by failurePolicy with Pause type
+	// when failed pod is found. The reason of the condition shows which one.
+	JobPaused JobConditionType = "Paused"
+)
+
+const (
+	// DeadlineExceededReason is the reason of JobFailed condition, when the job exceeds
+	// completionPolicy.activeDeadlineSeconds.
+	DeadlineExceededReason = "DeadlineExceeded"
+
+	// PodDeadlineExceededReason is the reason of failed pods and JobFailed condition,
+	// when pods exceed spec.podActiveDeadlineSeconds.
+	PodDeadlineExceededReason = "PodDeadlineExceeded"
 )
 
 // JobCondition describes current state of a job.
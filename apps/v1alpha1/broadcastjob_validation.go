@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateBroadcastJobPodActiveDeadline checks podActiveDeadlineSeconds is positive, and it is not longer than
+// completionPolicy.activeDeadlineSeconds, otherwise pods will never exceed it.
+func ValidateBroadcastJobPodActiveDeadline(spec *BroadcastJobSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.PodActiveDeadlineSeconds == nil {
+		return allErrs
+	}
+
+	deadlinePath := fldPath.Child("podActiveDeadlineSeconds")
+	podDeadline := *spec.PodActiveDeadlineSeconds
+	if podDeadline <= 0 {
+		allErrs = append(allErrs, field.Invalid(deadlinePath, podDeadline, "must be greater than 0"))
+	} else if jobDeadline := spec.CompletionPolicy.ActiveDeadlineSeconds; jobDeadline != nil && podDeadline > *jobDeadline {
+		allErrs = append(allErrs, field.Invalid(deadlinePath, podDeadline, "must not be greater than completionPolicy.activeDeadlineSeconds"))
+	}
+	return allErrs
+}
+
+// ValidateBroadcastJobCompletionPolicy checks the type of completionPolicy, and activeDeadlineSeconds and
+// ttlSecondsAfterFinished are only specified with Always type.
+func ValidateBroadcastJobCompletionPolicy(spec *BroadcastJobSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil {
+		return allErrs
+	}
+
+	policy := &spec.CompletionPolicy
+	policyPath := fldPath.Child("completionPolicy")
+	switch policy.Type {
+	case "", Always:
+		if policy.ActiveDeadlineSeconds != nil && *policy.ActiveDeadlineSeconds <= 0 {
+			allErrs = append(allErrs, field.Invalid(policyPath.Child("activeDeadlineSeconds"), *policy.ActiveDeadlineSeconds, "must be greater than 0"))
+		}
+		if policy.TTLSecondsAfterFinished != nil && *policy.TTLSecondsAfterFinished < 0 {
+			allErrs = append(allErrs, field.Invalid(policyPath.Child("ttlSecondsAfterFinished"), *policy.TTLSecondsAfterFinished, "must be greater than or equal to 0"))
+		}
+	case Never:
+		if policy.ActiveDeadlineSeconds != nil {
+			allErrs = append(allErrs, field.Forbidden(policyPath.Child("activeDeadlineSeconds"), fmt.Sprintf("only allowed for type '%s'", Always)))
+		}
+		if policy.TTLSecondsAfterFinished != nil {
+			allErrs = append(allErrs, field.Forbidden(policyPath.Child("ttlSecondsAfterFinished"), fmt.Sprintf("only allowed for type '%s'", Always)))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(policyPath.Child("type"), policy.Type, []string{string(Always), string(Never)}))
+	}
+	return allErrs
+}
+
+// ValidateBroadcastJobNodeSelection checks the label selector of nodeSelection is valid, and the names are not empty.
+func ValidateBroadcastJobNodeSelection(spec *BroadcastJobSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.NodeSelection == nil {
+		return allErrs
+	}
+
+	selection := spec.NodeSelection
+	selectionPath := fldPath.Child("nodeSelection")
+	if selection.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(selection.LabelSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(selectionPath.Child("labelSelector"), selection.LabelSelector, err.Error()))
+		}
+	}
+	for i, name := range selection.Names {
+		if name == "" {
+			allErrs = append(allErrs, field.Required(selectionPath.Child("names").Index(i), ""))
+		}
+	}
+	for i, name := range selection.ExcludeNames {
+		if name == "" {
+			allErrs = append(allErrs, field.Required(selectionPath.Child("excludeNames").Index(i), ""))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewCloneSetCondition creates a new CloneSet condition.
+func NewCloneSetCondition(condType CloneSetConditionType, status v1.ConditionStatus, reason, message string) CloneSetCondition {
+	return CloneSetCondition{
+		Type:               condType,
+		Status:             status,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// GetCloneSetCondition returns the condition with the provided type.
+func GetCloneSetCondition(status CloneSetStatus, condType CloneSetConditionType) *CloneSetCondition {
+	for i := range status.Conditions {
+		c := status.Conditions[i]
+		if c.Type == condType {
+			return &c
+		}
+	}
+	return nil
+}
+
+// SetCloneSetCondition updates the CloneSet to include the provided condition. If the condition that
+// we are about to add already exists and has the same status and reason, then we are not going to update it.
+func SetCloneSetCondition(status *CloneSetStatus, condition CloneSetCondition) {
+	currentCond := GetCloneSetCondition(*status, condition.Type)
+	if currentCond != nil && currentCond.Status == condition.Status && currentCond.Reason == condition.Reason {
+		return
+	}
+	// Do not update lastTransitionTime if the status of the condition doesn't change.
+	if currentCond != nil && currentCond.Status == condition.Status {
+		condition.LastTransitionTime = currentCond.LastTransitionTime
+	}
+	newConditions := filterOutCloneSetCondition(status.Conditions, condition.Type)
+	status.Conditions = append(newConditions, condition)
+}
+
+// RemoveCloneSetCondition removes the CloneSet condition with the provided type.
+func RemoveCloneSetCondition(status *CloneSetStatus, condType CloneSetConditionType) {
+	status.Conditions = filterOutCloneSetCondition(status.Conditions, condType)
+}
+
+// filterOutCloneSetCondition returns a new slice of CloneSet conditions without conditions with the provided type.
+func filterOutCloneSetCondition(conditions []CloneSetCondition, condType CloneSetConditionType) []CloneSetCondition {
+	var newConditions []CloneSetCondition
+	for _, c := range conditions {
+		if c.Type == condType {
+			continue
+		}
+		newConditions = append(newConditions, c)
+	}
+	return newConditions
+}
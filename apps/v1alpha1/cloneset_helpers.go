@@ -0,0 +1,83 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	appspub "github.com/openkruise/kruise-api/apps/pub"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+var _ appspub.ScalableWorkload = &CloneSet{}
+
+// GetReplicas returns the desired replicas of CloneSet, which defaults to 1.
+func (cs *CloneSet) GetReplicas() int32 {
+	if cs.Spec.Replicas == nil {
+		return 1
+	}
+	return *cs.Spec.Replicas
+}
+
+// SetReplicas sets the desired replicas of CloneSet.
+func (cs *CloneSet) SetReplicas(replicas int32) {
+	cs.Spec.Replicas = &replicas
+}
+
+// GetSelector returns the label selector of CloneSet.
+func (cs *CloneSet) GetSelector() *metav1.LabelSelector {
+	return cs.Spec.Selector
+}
+
+// GetStatusReplicas returns the number of pods created by CloneSet controller.
+func (cs *CloneSet) GetStatusReplicas() int32 {
+	return cs.Status.Replicas
+}
+
+// ExpectedUpdatedReplicas returns the number of pods expected to be updated, which is replicas - partition.
+// Partition in percentage is calculated from replicas by rounding up, and it is clamped to [0, replicas].
+// Pods kept by partitionSelector are unknown here, so the result is replicas if partitionSelector is set.
+func (cs *CloneSet) ExpectedUpdatedReplicas() (int32, error) {
+	return CalculateExpectedUpdatedReplicas(cs.GetReplicas(), cs.Spec.UpdateStrategy.Partition)
+}
+
+// CalculateExpectedUpdatedReplicas returns replicas - partition, with partition resolved against replicas
+// by rounding up and clamped to [0, replicas].
+func CalculateExpectedUpdatedReplicas(replicas int32, partition *intstr.IntOrString) (int32, error) {
+	if partition == nil {
+		return replicas, nil
+	}
+	p, err := intstr.GetValueFromIntOrPercent(partition, int(replicas), true)
+	if err != nil {
+		return 0, err
+	}
+	if p < 0 {
+		p = 0
+	} else if p > int(replicas) {
+		p = int(replicas)
+	}
+	return replicas - int32(p), nil
+}
+
+// GetRevisionReplicas returns the replicas of the given revision in status, or nil if not found.
+func (cs *CloneSet) GetRevisionReplicas(revision string) *CloneSetRevisionReplicas {
+	for i := range cs.Status.RevisionReplicas {
+		if cs.Status.RevisionReplicas[i].Revision == revision {
+			return &cs.Status.RevisionReplicas[i]
+		}
+	}
+	return nil
+}
@@ -71,8 +71,12 @@ type CloneSetSpec struct {
 	// Defaults to 0 (pod will be considered available as soon as it is ready)
 	MinReadySeconds int32 `json:"minReadySeconds,omitempty"`
 
-	// Lifecycle defines the lifecycle hooks for Pods pre-delete, in-place update.
+	// Lifecycle defines the lifecycle hooks for Pods pre-available(pre-normal), pre-delete, in-place update.
 	Lifecycle *appspub.Lifecycle `json:"lifecycle,omitempty"`
+
+	// VolumeClaimUpdateStrategy indicates the strategy that will be employed to update PVCs
+	// when the volumeClaimTemplates have been changed.
+	VolumeClaimUpdateStrategy CloneSetVolumeClaimUpdateStrategy `json:"volumeClaimUpdateStrategy,omitempty"`
 }
 
 // CloneSetScaleStrategy defines strategies for pods scale.
@@ -80,6 +84,18 @@ type CloneSetScaleStrategy struct {
 	// PodsToDelete is the names of Pod should be deleted.
 	// Note that this list will be truncated for non-existing pod names.
 	PodsToDelete []string `json:"podsToDelete,omitempty"`
+	// The maximum number of pods that can be unavailable during scale out.
+	// Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).
+	// Absolute number is calculated from percentage by rounding up.
+	// It only works during scaling out, and new pods will not be created until the unavailable pods
+	// are less than maxUnavailable.
+	// Defaults to nil, which means no limit.
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// DisablePVCReuse indicates whether to disable the reuse of PVCs when pods are deleted or recreated.
+	// By default the PVCs of a deleted pod will be reused by the new pod with the same instance-id,
+	// set it to true if the stale data in PVCs is harmful, then the PVCs will be deleted along with the pod.
+	// Default value is false.
+	DisablePVCReuse bool `json:"disablePVCReuse,omitempty"`
 }
 
 // CloneSetUpdateStrategy defines strategies for pods update.
@@ -93,6 +109,10 @@ type CloneSetUpdateStrategy struct {
 	// It means when partition is set during pods updating, (replicas - partition value) number of pods will be updated.
 	// Default value is 0.
 	Partition *intstr.IntOrString `json:"partition,omitempty"`
+	// PartitionSelector selects the pods to be kept in old revisions by labels, such as canary=false.
+	// It is an alternative to partition, and they can not be set together.
+	// Pods matching the selector will not be updated, and the others will be updated.
+	PartitionSelector *metav1.LabelSelector `json:"partitionSelector,omitempty"`
 	// The maximum number of pods that can be unavailable during update or scale.
 	// Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).
 	// Absolute number is calculated from percentage by rounding up by default.
@@ -117,8 +137,23 @@ type CloneSetUpdateStrategy struct {
 	ScatterStrategy UpdateScatterStrategy `json:"scatterStrategy,omitempty"`
 	// InPlaceUpdateStrategy contains strategies for in-place update.
 	InPlaceUpdateStrategy *appspub.InPlaceUpdateStrategy `json:"inPlaceUpdateStrategy,omitempty"`
+	// RecreatePolicy indicates how to remove the old Pods when they have to be recreated during update.
+	// Default is Delete.
+	RecreatePolicy CloneSetRecreatePolicyType `json:"recreatePolicy,omitempty"`
 }
 
+// CloneSetRecreatePolicyType defines how to remove Pods when recreating.
+type CloneSetRecreatePolicyType string
+
+const (
+	// DeleteCloneSetRecreatePolicyType indicates that old Pods are deleted directly before creating new Pods,
+	// which is the default behavior.
+	DeleteCloneSetRecreatePolicyType CloneSetRecreatePolicyType = "Delete"
+	// EvictCloneSetRecreatePolicyType indicates that old Pods are removed through the eviction API,
+	// so that PodDisruptionBudgets are respected during update.
+	EvictCloneSetRecreatePolicyType CloneSetRecreatePolicyType = "Evict"
+)
+
 // CloneSetUpdateStrategyType defines strategies for pods in-place update.
 type CloneSetUpdateStrategyType string
 
@@ -127,15 +162,37 @@ const (
 	// during Pod update, which is the default behavior.
 	RecreateCloneSetUpdateStrategyType CloneSetUpdateStrategyType = "ReCreate"
 	// InPlaceIfPossibleCloneSetUpdateStrategyType indicates that we try to in-place update Pod instead of
-	// recreating Pod when possible. Currently, only image update of pod spec is allowed. Any other changes to the pod
+	// recreating Pod when possible. Currently, only image update of pod spec is allowed, and resources update is also
+	// allowed if the Resources feature is enabled in inPlaceUpdateStrategy. Any other changes to the pod
 	// spec will fall back to ReCreate CloneSetUpdateStrategyType where pod will be recreated.
 	InPlaceIfPossibleCloneSetUpdateStrategyType CloneSetUpdateStrategyType = "InPlaceIfPossible"
 	// InPlaceOnlyCloneSetUpdateStrategyType indicates that we will in-place update Pod instead of
-	// recreating pod. Currently we only allow image update for pod spec. Any other changes to the pod spec will be
+	// recreating pod. Currently we only allow image update for pod spec, and resources update if the Resources
+	// feature is enabled in inPlaceUpdateStrategy. Any other changes to the pod spec will be
 	// rejected by kube-apiserver
 	InPlaceOnlyCloneSetUpdateStrategyType CloneSetUpdateStrategyType = "InPlaceOnly"
 )
 
+// CloneSetVolumeClaimUpdateStrategyType defines strategies for PVCs update.
+type CloneSetVolumeClaimUpdateStrategyType string
+
+const (
+	// RecreateCloneSetVolumeClaimUpdateStrategyType indicates that changes of volumeClaimTemplates trigger
+	// the recreation of Pods along with their PVCs, which is the default behavior.
+	RecreateCloneSetVolumeClaimUpdateStrategyType CloneSetVolumeClaimUpdateStrategyType = "ReCreate"
+	// InPlaceExpandCloneSetVolumeClaimUpdateStrategyType indicates that we try to expand the existing PVCs
+	// in-place when only the storage requests of volumeClaimTemplates have been increased, without recreating Pods.
+	// Any other changes to the volumeClaimTemplates will fall back to ReCreate.
+	InPlaceExpandCloneSetVolumeClaimUpdateStrategyType CloneSetVolumeClaimUpdateStrategyType = "InPlaceExpand"
+)
+
+// CloneSetVolumeClaimUpdateStrategy defines strategies for PVCs update.
+type CloneSetVolumeClaimUpdateStrategy struct {
+	// Type indicates the type of the CloneSetVolumeClaimUpdateStrategy.
+	// Default is ReCreate.
+	Type CloneSetVolumeClaimUpdateStrategyType `json:"type,omitempty"`
+}
+
 // CloneSetStatus defines the observed state of CloneSet
 type CloneSetStatus struct {
 	// ObservedGeneration is the most recent generation observed for this CloneSet. It corresponds to the
@@ -159,6 +216,10 @@ type CloneSetStatus struct {
 	// indicated by updateRevision and have a Ready Condition.
 	UpdatedReadyReplicas int32 `json:"updatedReadyReplicas"`
 
+	// ExpectedUpdatedReplicas is the number of Pods that should be updated by CloneSet controller.
+	// This field is calculated via Replicas - Partition.
+	ExpectedUpdatedReplicas int32 `json:"expectedUpdatedReplicas,omitempty"`
+
 	// UpdateRevision, if not empty, indicates the latest revision of the CloneSet.
 	UpdateRevision string `json:"updateRevision,omitempty"`
 
@@ -175,6 +236,41 @@ type CloneSetStatus struct {
 
 	// LabelSelector is label selectors for query over pods that should match the replica count used by HPA.
 	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// VolumeClaims represents the status of compatibility between existing PVCs
+	// and their respective volumeClaimTemplates, one item for each volumeClaimTemplate.
+	VolumeClaims []CloneSetVolumeClaimStatus `json:"volumeClaims,omitempty"`
+
+	// RevisionReplicas records the replicas of each revision that Pods are in, which is
+	// useful to observe the states of multiple revisions when partition is used.
+	RevisionReplicas []CloneSetRevisionReplicas `json:"revisionReplicas,omitempty"`
+}
+
+// CloneSetRevisionReplicas records the replicas of Pods in a revision.
+type CloneSetRevisionReplicas struct {
+	// Revision is the hash of the revision.
+	Revision string `json:"revision"`
+	// Replicas is the number of Pods in this revision.
+	Replicas int32 `json:"replicas"`
+	// ReadyReplicas is the number of Pods in this revision that have a Ready Condition.
+	ReadyReplicas int32 `json:"readyReplicas"`
+	// AvailableReplicas is the number of Pods in this revision that have a Ready Condition for at least minReadySeconds.
+	AvailableReplicas int32 `json:"availableReplicas"`
+}
+
+// CloneSetVolumeClaimStatus records the PVCs status of a volumeClaimTemplate.
+// The PVCs incompatible with the volumeClaimTemplate are Replicas - CompatibleReplicas.
+type CloneSetVolumeClaimStatus struct {
+	// VolumeClaimName is the name of the volumeClaimTemplate.
+	VolumeClaimName string `json:"volumeClaimName"`
+	// Replicas is the number of PVCs created from the volumeClaimTemplate.
+	Replicas int32 `json:"replicas"`
+	// CompatibleReplicas is the number of PVCs compatible with the volumeClaimTemplate,
+	// e.g. have been expanded to the requested size.
+	CompatibleReplicas int32 `json:"compatibleReplicas"`
+	// CompatibleReadyReplicas is the number of Pods whose PVCs are compatible with the volumeClaimTemplate
+	// and have a Ready Condition.
+	CompatibleReadyReplicas int32 `json:"compatibleReadyReplicas"`
 }
 
 // CloneSetConditionType is type for CloneSet conditions.
@@ -185,6 +281,9 @@ const (
 	CloneSetConditionFailedScale CloneSetConditionType = "FailedScale"
 	// CloneSetConditionFailedUpdate indicates cloneset controller failed to update pods.
 	CloneSetConditionFailedUpdate CloneSetConditionType = "FailedUpdate"
+	// CloneSetConditionFailedProvisionPVC indicates cloneset controller failed to provision pvcs for pods,
+	// which is more specific than CloneSetConditionFailedScale.
+	CloneSetConditionFailedProvisionPVC CloneSetConditionType = "FailedProvisionPVC"
 )
 
 // CloneSetCondition describes the state of a CloneSet at a certain point.
@@ -0,0 +1,76 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// NewDaemonSetCondition creates a new DaemonSet condition.
+func NewDaemonSetCondition(condType DaemonSetConditionType, status v1.ConditionStatus, reason, message string) DaemonSetCondition {
+	return DaemonSetCondition{
+		Type:               condType,
+		Status:             status,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// GetDaemonSetCondition returns the condition with the provided type.
+func GetDaemonSetCondition(status DaemonSetStatus, condType DaemonSetConditionType) *DaemonSetCondition {
+	for i := range status.Conditions {
+		c := status.Conditions[i]
+		if c.Type == condType {
+			return &c
+		}
+	}
+	return nil
+}
+
+// SetDaemonSetCondition updates the DaemonSet to include the provided condition. If the condition that
+// we are about to add already exists and has the same status and reason, then we are not going to update it.
+func SetDaemonSetCondition(status *DaemonSetStatus, condition DaemonSetCondition) {
+	currentCond := GetDaemonSetCondition(*status, condition.Type)
+	if currentCond != nil && currentCond.Status == condition.Status && currentCond.Reason == condition.Reason {
+		return
+	}
+	// Do not update lastTransitionTime if the status of the condition doesn't change.
+	if currentCond != nil && currentCond.Status == condition.Status {
+		condition.LastTransitionTime = currentCond.LastTransitionTime
+	}
+	newConditions := filterOutDaemonSetCondition(status.Conditions, condition.Type)
+	status.Conditions = append(newConditions, condition)
+}
+
+// RemoveDaemonSetCondition removes the DaemonSet condition with the provided type.
+func RemoveDaemonSetCondition(status *DaemonSetStatus, condType DaemonSetConditionType) {
+	status.Conditions = filterOutDaemonSetCondition(status.Conditions, condType)
+}
+
+// filterOutDaemonSetCondition returns a new slice of DaemonSet conditions without conditions with the provided type.
+func filterOutDaemonSetCondition(conditions []DaemonSetCondition, condType DaemonSetConditionType) []DaemonSetCondition {
+	var newConditions []DaemonSetCondition
+	for _, c := range conditions {
+		if c.Type == condType {
+			continue
+		}
+		newConditions = append(newConditions, c)
+	}
+	return newConditions
+}
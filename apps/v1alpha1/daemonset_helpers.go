@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// IsPaused returns true if the update of DaemonSet is paused by either updateStrategy.paused
+// or updateStrategy.rollingUpdate.paused.
+func (ds *DaemonSet) IsPaused() bool {
+	if ds.Spec.UpdateStrategy.Paused {
+		return true
+	}
+	rollingUpdate := ds.Spec.UpdateStrategy.RollingUpdate
+	return rollingUpdate != nil && rollingUpdate.Paused != nil && *rollingUpdate.Paused
+}
+
+// IsProgressive returns true if the daemon pods should be created progressively, which is decided by
+// rollingUpdate.progressive, or the deprecated ProgressiveCreatePodAnnotation if the field is not specified.
+func (ds *DaemonSet) IsProgressive() bool {
+	rollingUpdate := ds.Spec.UpdateStrategy.RollingUpdate
+	if rollingUpdate != nil && rollingUpdate.Progressive != nil {
+		return *rollingUpdate.Progressive
+	}
+	return ds.Annotations[ProgressiveCreatePodAnnotation] == "true"
+}
+
+// ResolvePartition returns the number of daemon pods remained to be old version, from partitionPercent if it is
+// specified, otherwise from partition. Percentage is calculated from desiredNumberScheduled by rounding up,
+// and the result is clamped to [0, desiredNumberScheduled].
+func (r *RollingUpdateDaemonSet) ResolvePartition(desiredNumberScheduled int32) (int32, error) {
+	partition := r.PartitionPercent
+	if partition == nil && r.Partition != nil {
+		p := intstr.FromInt(int(*r.Partition))
+		partition = &p
+	}
+	expectedUpdated, err := CalculateExpectedUpdatedReplicas(desiredNumberScheduled, partition)
+	if err != nil {
+		return 0, err
+	}
+	return desiredNumberScheduled - expectedUpdated, nil
+}
+
+// IsTaintIgnored returns true if the taint is tolerated by any of spec.ignoredTaints,
+// which means it should be ignored when computing the desired nodes.
+func (ds *DaemonSet) IsTaintIgnored(taint *corev1.Taint) bool {
+	for i := range ds.Spec.IgnoredTaints {
+		if ds.Spec.IgnoredTaints[i].ToleratesTaint(taint) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestDaemonSetIsPaused(t *testing.T) {
+	paused, notPaused := true, false
+	cases := []struct {
+		name     string
+		strategy DaemonSetUpdateStrategy
+		expected bool
+	}{
+		{name: "not paused"},
+		{name: "strategy paused", strategy: DaemonSetUpdateStrategy{Paused: true}, expected: true},
+		{name: "rollingUpdate paused", strategy: DaemonSetUpdateStrategy{RollingUpdate: &RollingUpdateDaemonSet{Paused: &paused}}, expected: true},
+		{name: "rollingUpdate not paused", strategy: DaemonSetUpdateStrategy{RollingUpdate: &RollingUpdateDaemonSet{Paused: &notPaused}}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			ds := &DaemonSet{Spec: DaemonSetSpec{UpdateStrategy: tc.strategy}}
+			if got := ds.IsPaused(); got != tc.expected {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestRollingUpdateDaemonSetResolvePartition(t *testing.T) {
+	partition := int32(3)
+	overPartition := int32(20)
+	percent := intstr.FromString("25%")
+	cases := []struct {
+		name          string
+		rollingUpdate RollingUpdateDaemonSet
+		expected      int32
+	}{
+		{name: "unset", expected: 0},
+		{name: "partition", rollingUpdate: RollingUpdateDaemonSet{Partition: &partition}, expected: 3},
+		{name: "partition over desired", rollingUpdate: RollingUpdateDaemonSet{Partition: &overPartition}, expected: 10},
+		// 25% of 10 is rounded up to 3.
+		{name: "partitionPercent", rollingUpdate: RollingUpdateDaemonSet{PartitionPercent: &percent}, expected: 3},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.rollingUpdate.ResolvePartition(10)
+			if err != nil {
+				t.Fatalf("expected no error, got %v", err)
+			}
+			if got != tc.expected {
+				t.Fatalf("expected %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
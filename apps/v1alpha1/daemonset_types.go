@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	appspub "github.com/openkruise/kruise-api/apps/pub"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -31,6 +32,16 @@ type DaemonSetUpdateStrategy struct {
 	// Rolling update config params. Present only if type = "RollingUpdate".
 	// +optional
 	RollingUpdate *RollingUpdateDaemonSet `json:"rollingUpdate,omitempty" protobuf:"bytes,2,opt,name=rollingUpdate"`
+
+	// InPlaceUpdateStrategy contains strategies for in-place update.
+	// It only works when rollingUpdate.rollingUpdateType is InPlaceIfPossible.
+	// +optional
+	InPlaceUpdateStrategy *appspub.InPlaceUpdateStrategy `json:"inPlaceUpdateStrategy,omitempty" protobuf:"bytes,3,opt,name=inPlaceUpdateStrategy"`
+
+	// Paused indicates that the update of DaemonSet is paused, like the paused of other Kruise workloads.
+	// rollingUpdate.paused is kept for compatibility, and the DaemonSet is paused if either of them is true.
+	// +optional
+	Paused bool `json:"paused,omitempty" protobuf:"varint,4,opt,name=paused"`
 }
 
 type DaemonSetUpdateStrategyType string
@@ -47,8 +58,9 @@ const (
 	// this is the default type for RollingUpdate.
 	StandardRollingUpdateType RollingUpdateType = "Standard"
 
-	// Replace container image without killing the pod.
-	//InplaceRollingUpdateType RollingUpdateType = "Inplace"
+	// InplaceRollingUpdateType replaces the old daemons by updating them in-place if possible, e.g. only the images
+	// of containers changed, otherwise it works like StandardRollingUpdateType.
+	InplaceRollingUpdateType RollingUpdateType = "InPlaceIfPossible"
 
 	// SurgingRollingUpdateType replaces the old daemons by new ones using rolling update i.e replace them on each node one
 	// after the other, creating the new pod and then killing the old one.
@@ -80,6 +92,7 @@ type RollingUpdateDaemonSet struct {
 	// A label query over nodes that are managed by the daemon set RollingUpdate.
 	// Must match in order to be controlled.
 	// It must match the node's labels.
+	// Only daemon pods on the selected nodes will be updated, which can be used for canary by node pools.
 	Selector *metav1.LabelSelector `json:"selector,omitempty" protobuf:"bytes,3,opt,name=selector"`
 
 	// The number of DaemonSet pods remained to be old version.
@@ -88,8 +101,14 @@ type RollingUpdateDaemonSet struct {
 	// +optional
 	Partition *int32 `json:"partition,omitempty" protobuf:"varint,4,opt,name=partition"`
 
+	// PartitionPercent is the partition that can be an absolute number (ex: 5) or a percentage of
+	// status.desiredNumberScheduled (ex: 10%). Absolute number is calculated from percentage by rounding up.
+	// It can not be set together with partition.
+	// +optional
+	PartitionPercent *intstr.IntOrString `json:"partitionPercent,omitempty" protobuf:"bytes,9,opt,name=partitionPercent"`
+
 	// Indicates that the daemon set is paused and will not be processed by the
-	// daemon set controller.
+	// daemon set controller. The daemon pods on new nodes are still created.
 	// +optional
 	Paused *bool `json:"paused,omitempty" protobuf:"varint,5,opt,name=paused"`
 
@@ -106,6 +125,13 @@ type RollingUpdateDaemonSet struct {
 	// times during the update.
 	// +optional
 	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty" protobuf:"bytes,7,opt,name=maxSurge"`
+
+	// Progressive indicates that the new daemon pods on the nodes without daemon pod, e.g. the newly added nodes,
+	// are also created progressively respecting partition and selector, instead of being created with the latest
+	// revision directly. The pods kept by partition or not selected are created with the current revision.
+	// It replaces the deprecated ProgressiveCreatePodAnnotation annotation.
+	// +optional
+	Progressive *bool `json:"progressive,omitempty" protobuf:"varint,8,opt,name=progressive"`
 }
 
 // DaemonSetSpec defines the desired state of DaemonSet
@@ -146,6 +172,18 @@ type DaemonSetSpec struct {
 	// Defaults to 10.
 	// +optional
 	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty" protobuf:"varint,6,opt,name=revisionHistoryLimit"`
+
+	// Lifecycle defines the lifecycle hooks for Pods pre-delete, in-place update.
+	// Currently, we only support pre-delete hook for Advanced DaemonSet, which makes the daemon pods
+	// able to be drained before deletion during rolling update.
+	// +optional
+	Lifecycle *appspub.Lifecycle `json:"lifecycle,omitempty" protobuf:"bytes,7,opt,name=lifecycle"`
+
+	// IgnoredTaints are the taints to be ignored when computing the nodes that should be running the daemon pod.
+	// A taint is ignored if it is tolerated by any of them, which works like the tolerations of pod template,
+	// but only takes effect in the calculation of desired nodes.
+	// +optional
+	IgnoredTaints []corev1.Toleration `json:"ignoredTaints,omitempty" protobuf:"bytes,8,rep,name=ignoredTaints"`
 }
 
 // DaemonSetStatus defines the observed state of DaemonSet
@@ -205,11 +243,57 @@ type DaemonSetStatus struct {
 
 	// DaemonSetHash is the controller-revision-hash, which represents the latest version of the DaemonSet.
 	DaemonSetHash string `json:"daemonSetHash" protobuf:"bytes,11,opt,name=daemonSetHash"`
+
+	// The number of nodes that should be running the daemon pod and are selected
+	// by spec.updateStrategy.rollingUpdate.selector.
+	// It equals to desiredNumberScheduled if the selector is not specified.
+	// +optional
+	SelectedNumberScheduled int32 `json:"selectedNumberScheduled,omitempty" protobuf:"varint,12,opt,name=selectedNumberScheduled"`
+
+	// The number of nodes that are selected by spec.updateStrategy.rollingUpdate.selector
+	// and are running updated daemon pod.
+	// +optional
+	UpdatedSelectedNumberScheduled int32 `json:"updatedSelectedNumberScheduled,omitempty" protobuf:"varint,13,opt,name=updatedSelectedNumberScheduled"`
+
+	// The number of nodes that are running updated daemon pod and have one
+	// or more of the daemon pod running and ready.
+	// +optional
+	UpdatedReadyNumberScheduled int32 `json:"updatedReadyNumberScheduled,omitempty" protobuf:"varint,14,opt,name=updatedReadyNumberScheduled"`
+
+	// NodePools is the breakdown of the numbers by the value of node label, whose key is specified
+	// by the DaemonSetNodePoolLabelKeyAnnotation annotation of the DaemonSet.
+	// It is empty if the annotation is not specified.
+	// +optional
+	NodePools []DaemonSetNodePoolStatus `json:"nodePools,omitempty" protobuf:"bytes,15,rep,name=nodePools"`
+}
+
+// DaemonSetNodePoolStatus records the numbers of the nodes with the same value of the node pool label.
+type DaemonSetNodePoolStatus struct {
+	// Value of the node pool label, which is empty for the nodes without the label.
+	Value string `json:"value" protobuf:"bytes,1,opt,name=value"`
+	// The total number of nodes in the pool that should be running the daemon pod.
+	DesiredNumberScheduled int32 `json:"desiredNumberScheduled" protobuf:"varint,2,opt,name=desiredNumberScheduled"`
+	// The number of nodes in the pool that should be running the daemon pod and have
+	// one or more of the daemon pod running and ready.
+	NumberReady int32 `json:"numberReady" protobuf:"varint,3,opt,name=numberReady"`
+	// The number of nodes in the pool that are running updated daemon pod.
+	UpdatedNumberScheduled int32 `json:"updatedNumberScheduled" protobuf:"varint,4,opt,name=updatedNumberScheduled"`
+	// The number of nodes in the pool that are running updated daemon pod and have
+	// one or more of the daemon pod running and ready.
+	UpdatedReadyNumberScheduled int32 `json:"updatedReadyNumberScheduled" protobuf:"varint,5,opt,name=updatedReadyNumberScheduled"`
 }
 
 type DaemonSetConditionType string
 
-// TODO: Add valid condition types of a DaemonSet.
+// These are valid conditions of a DaemonSet.
+const (
+	// DaemonSetConditionFailedDaemonPodCreate means the DaemonSet controller fails to create daemon pods on some nodes,
+	// the reason and message of the condition show the details.
+	DaemonSetConditionFailedDaemonPodCreate DaemonSetConditionType = "FailedDaemonPodCreate"
+	// DaemonSetConditionRollingUpdatePaused means the rolling update of DaemonSet is paused by
+	// spec.updateStrategy.rollingUpdate.paused.
+	DaemonSetConditionRollingUpdatePaused DaemonSetConditionType = "RollingUpdatePaused"
+)
 
 // DaemonSetCondition describes the state of a DaemonSet at a certain point.
 type DaemonSetCondition struct {
@@ -233,6 +317,15 @@ const (
 	// to existing DaemonSet pods to distinguish between old and new
 	// DaemonSet pods during DaemonSet template updates.
 	DefaultDaemonSetUniqueLabelKey = ControllerRevisionHashLabelKey
+
+	// DaemonSetNodePoolLabelKeyAnnotation is the annotation of DaemonSet, whose value is the key of node label
+	// used to break down the numbers in status.nodePools, such as topology.kubernetes.io/zone.
+	DaemonSetNodePoolLabelKeyAnnotation = "apps.kruise.io/daemonset-node-pool-label-key"
+
+	// ProgressiveCreatePodAnnotation is the annotation of DaemonSet, and it makes daemon pods created
+	// progressively if the value is "true".
+	// Deprecated: use spec.updateStrategy.rollingUpdate.progressive instead.
+	ProgressiveCreatePodAnnotation = "daemonset.kruise.io/progressive-create-pod"
 )
 
 // +genclient
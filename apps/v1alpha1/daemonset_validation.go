@@ -0,0 +1,131 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateDaemonSetRollingUpdateSelector checks the node selector of rolling update is a valid label selector.
+func ValidateDaemonSetRollingUpdateSelector(spec *DaemonSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.UpdateStrategy.RollingUpdate == nil || spec.UpdateStrategy.RollingUpdate.Selector == nil {
+		return allErrs
+	}
+
+	selector := spec.UpdateStrategy.RollingUpdate.Selector
+	selectorPath := fldPath.Child("updateStrategy", "rollingUpdate", "selector")
+	if _, err := metav1.LabelSelectorAsSelector(selector); err != nil {
+		allErrs = append(allErrs, field.Invalid(selectorPath, selector, err.Error()))
+	}
+	return allErrs
+}
+
+// ValidateDaemonSetLifecycle checks the pre-normal hook is not specified in lifecycle, which is not supported
+// by Advanced DaemonSet, and the in-place update hook is only specified with InPlaceIfPossible rolling update type.
+func ValidateDaemonSetLifecycle(spec *DaemonSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.Lifecycle == nil {
+		return allErrs
+	}
+
+	lifecyclePath := fldPath.Child("lifecycle")
+	if spec.Lifecycle.PreNormal != nil {
+		allErrs = append(allErrs, field.Forbidden(lifecyclePath.Child("preNormal"), "not supported by Advanced DaemonSet"))
+	}
+	if spec.Lifecycle.InPlaceUpdate != nil {
+		rollingUpdate := spec.UpdateStrategy.RollingUpdate
+		if rollingUpdate == nil || rollingUpdate.Type != InplaceRollingUpdateType {
+			allErrs = append(allErrs, field.Forbidden(lifecyclePath.Child("inPlaceUpdate"),
+				fmt.Sprintf("only allowed for rollingUpdateType '%s'", InplaceRollingUpdateType)))
+		}
+	}
+	return allErrs
+}
+
+// ValidateDaemonSetProgressive checks progressive is only specified with RollingUpdate type.
+func ValidateDaemonSetProgressive(spec *DaemonSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.UpdateStrategy.RollingUpdate == nil || spec.UpdateStrategy.RollingUpdate.Progressive == nil {
+		return allErrs
+	}
+
+	if spec.UpdateStrategy.Type == OnDeleteDaemonSetStrategyType {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("updateStrategy", "rollingUpdate", "progressive"),
+			fmt.Sprintf("only allowed for updateStrategy '%s'", RollingUpdateDaemonSetStrategyType)))
+	}
+	return allErrs
+}
+
+// WarningsForDaemonSet returns the warnings of the DaemonSet, which are advisory like the ones of
+// WarningsForStatefulSetSpec. For now it only reports the deprecated ProgressiveCreatePodAnnotation.
+func WarningsForDaemonSet(ds *DaemonSet) []string {
+	var warnings []string
+	if ds == nil {
+		return warnings
+	}
+
+	if _, ok := ds.Annotations[ProgressiveCreatePodAnnotation]; ok {
+		warnings = append(warnings, fmt.Sprintf("%s: deprecated, use spec.updateStrategy.rollingUpdate.progressive instead",
+			field.NewPath("metadata", "annotations").Key(ProgressiveCreatePodAnnotation)))
+	}
+	return warnings
+}
+
+// ValidateDaemonSetIgnoredTaints checks spec.ignoredTaints like the tolerations of pod.
+func ValidateDaemonSetIgnoredTaints(spec *DaemonSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil {
+		return allErrs
+	}
+
+	taintsPath := fldPath.Child("ignoredTaints")
+	for i, t := range spec.IgnoredTaints {
+		idxPath := taintsPath.Index(i)
+		if t.Key != "" {
+			for _, msg := range validation.IsQualifiedName(t.Key) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("key"), t.Key, msg))
+			}
+		}
+		switch t.Operator {
+		case corev1.TolerationOpEqual, "":
+			if t.Key == "" {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("operator"), t.Operator,
+					"operator must be Exists when `key` is empty, which means \"match all values and all keys\""))
+			}
+		case corev1.TolerationOpExists:
+			if t.Value != "" {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("value"), t.Value, "value must be empty when `operator` is 'Exists'"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("operator"), t.Operator,
+				[]string{string(corev1.TolerationOpEqual), string(corev1.TolerationOpExists)}))
+		}
+		switch t.Effect {
+		case "", corev1.TaintEffectNoSchedule, corev1.TaintEffectPreferNoSchedule, corev1.TaintEffectNoExecute:
+		default:
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("effect"), t.Effect, []string{
+				string(corev1.TaintEffectNoSchedule), string(corev1.TaintEffectPreferNoSchedule), string(corev1.TaintEffectNoExecute)}))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,53 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestWarningsForDaemonSet(t *testing.T) {
+	cases := []struct {
+		name             string
+		annotations      map[string]string
+		expectedWarnings []string
+	}{
+		{name: "no annotations"},
+		{
+			name:        "deprecated progressive annotation",
+			annotations: map[string]string{ProgressiveCreatePodAnnotation: "true"},
+			expectedWarnings: []string{"metadata.annotations[daemonset.kruise.io/progressive-create-pod]: " +
+				"deprecated, use spec.updateStrategy.rollingUpdate.progressive instead"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			warnings := WarningsForDaemonSet(&DaemonSet{ObjectMeta: metav1.ObjectMeta{Annotations: tc.annotations}})
+			if len(warnings) != len(tc.expectedWarnings) {
+				t.Fatalf("expected %d warnings, got %v", len(tc.expectedWarnings), warnings)
+			}
+			for i, w := range warnings {
+				if w != tc.expectedWarnings[i] {
+					t.Errorf("expected warning %q, got %q", tc.expectedWarnings[i], w)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,65 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// ImagePullJobNameLabelKey is the label on the BroadcastJobs created for an ImagePullJob,
+	// whose value is the name of the ImagePullJob.
+	ImagePullJobNameLabelKey = "apps.kruise.io/image-pull-job-name"
+	// ImagePullJobUIDLabelKey is the label on the BroadcastJobs created for an ImagePullJob,
+	// whose value is the uid of the ImagePullJob, to tell apart the jobs recreated with the same name.
+	ImagePullJobUIDLabelKey = "apps.kruise.io/image-pull-job-uid"
+)
+
+// ImagePullJobKind is the kind of ImagePullJob in the apps.kruise.io group.
+var ImagePullJobKind = SchemeGroupVersion.WithKind("ImagePullJob")
+
+// NewImagePullJobOwnerReference returns the controller reference to the ImagePullJob,
+// which should be set on the BroadcastJobs created for it.
+func NewImagePullJobOwnerReference(job *ImagePullJob) *metav1.OwnerReference {
+	return metav1.NewControllerRef(job, ImagePullJobKind)
+}
+
+// SetImagePullJobLabels sets the labels of the ImagePullJob on the object created for it.
+func SetImagePullJobLabels(obj metav1.Object, job *ImagePullJob) {
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[ImagePullJobNameLabelKey] = job.Name
+	labels[ImagePullJobUIDLabelKey] = string(job.UID)
+	obj.SetLabels(labels)
+}
+
+// GetImagePullJobOwner returns the name and uid of the ImagePullJob that controls the object, e.g. a BroadcastJob,
+// and false if the object is not controlled by an ImagePullJob.
+func GetImagePullJobOwner(obj metav1.Object) (string, types.UID, bool) {
+	ref := metav1.GetControllerOf(obj)
+	if ref == nil || ref.Kind != ImagePullJobKind.Kind {
+		return "", "", false
+	}
+	if gv, err := schema.ParseGroupVersion(ref.APIVersion); err != nil || gv.Group != SchemeGroupVersion.Group {
+		return "", "", false
+	}
+	return ref.Name, ref.UID, true
+}
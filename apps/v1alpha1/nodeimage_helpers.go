@@ -0,0 +1,69 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+)
+
+// IsImagePulled returns true if the image has been successfully pulled on the node,
+// according to the status of NodeImage. Image without tag is regarded as latest, and image
+// with digest is always regarded as not pulled since NodeImage records tags only.
+func (n *NodeImage) IsImagePulled(image string) bool {
+	if strings.Contains(image, "@") {
+		return false
+	}
+	name, tag := splitImageTag(image)
+	imageStatus, ok := n.Status.ImageStatuses[name]
+	if !ok {
+		return false
+	}
+	for _, t := range imageStatus.Tags {
+		if t.Tag == tag {
+			return t.Phase == ImagePhaseSucceeded
+		}
+	}
+	return false
+}
+
+// GetImagePulledNodes returns the names of nodes that have pulled all the images,
+// which can be used by UpdatePriorityStrategy.ImagePulledPriority.
+func GetImagePulledNodes(nodeImages []NodeImage, images []string) map[string]bool {
+	nodes := make(map[string]bool, len(nodeImages))
+	for i := range nodeImages {
+		pulled := true
+		for _, image := range images {
+			if !nodeImages[i].IsImagePulled(image) {
+				pulled = false
+				break
+			}
+		}
+		if pulled {
+			nodes[nodeImages[i].Name] = true
+		}
+	}
+	return nodes
+}
+
+// splitImageTag splits the image into name and tag, such as "nginx:1.19" into "nginx" and "1.19".
+func splitImageTag(image string) (string, string) {
+	idx := strings.LastIndex(image, ":")
+	if idx < 0 || strings.Contains(image[idx+1:], "/") {
+		return image, "latest"
+	}
+	return image[:idx], image[idx+1:]
+}
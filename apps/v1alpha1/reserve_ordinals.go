@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// maxParsedOrdinals is the max number of ordinals ParseOrdinals accepts, counting each range by its full size
+// even if it overlaps with others. The string usually comes from annotations that anyone can write, so it must
+// be bounded to avoid allocating unlimited memory.
+const maxParsedOrdinals = 100000
+
+// FormatOrdinals formats the ordinals into a compact range string, such as "1-3,7".
+// Ordinals will be sorted and deduplicated.
+func FormatOrdinals(ordinals []int) string {
+	if len(ordinals) == 0 {
+		return ""
+	}
+
+	sorted := make([]int, len(ordinals))
+	copy(sorted, ordinals)
+	sort.Ints(sorted)
+
+	var parts []string
+	start, end := sorted[0], sorted[0]
+	flush := func() {
+		if start == end {
+			parts = append(parts, strconv.Itoa(start))
+		} else {
+			parts = append(parts, fmt.Sprintf("%d-%d", start, end))
+		}
+	}
+	for _, o := range sorted[1:] {
+		if o <= end+1 {
+			if o > end {
+				end = o
+			}
+			continue
+		}
+		flush()
+		start, end = o, o
+	}
+	flush()
+	return strings.Join(parts, ",")
+}
+
+// ParseOrdinals parses the compact range string generated by FormatOrdinals.
+// Overlapping and out-of-order ranges are allowed, the result is sorted and deduplicated.
+// It returns error if the ranges contain more than maxParsedOrdinals ordinals in total.
+func ParseOrdinals(s string) ([]int, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return nil, nil
+	}
+
+	set := make(map[int]struct{})
+	var total int
+	for _, part := range strings.Split(s, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, fmt.Errorf("empty ordinal in %q", s)
+		}
+
+		bounds := strings.SplitN(part, "-", 2)
+		start, err := parseOrdinal(bounds[0])
+		if err != nil {
+			return nil, err
+		}
+		end := start
+		if len(bounds) == 2 {
+			if end, err = parseOrdinal(bounds[1]); err != nil {
+				return nil, err
+			}
+			if end < start {
+				return nil, fmt.Errorf("invalid ordinal range %q", part)
+			}
+		}
+		if total += end - start + 1; total > maxParsedOrdinals {
+			return nil, fmt.Errorf("too many ordinals in %q, the limit is %d", s, maxParsedOrdinals)
+		}
+		for i := start; i <= end; i++ {
+			set[i] = struct{}{}
+		}
+	}
+
+	ordinals := make([]int, 0, len(set))
+	for o := range set {
+		ordinals = append(ordinals, o)
+	}
+	sort.Ints(ordinals)
+	return ordinals, nil
+}
+
+func parseOrdinal(s string) (int, error) {
+	o, err := strconv.Atoi(strings.TrimSpace(s))
+	if err != nil {
+		return 0, fmt.Errorf("invalid ordinal %q: %v", s, err)
+	}
+	if o < 0 {
+		return 0, fmt.Errorf("invalid ordinal %q: must be non-negative", s)
+	}
+	return o, nil
+}
@@ -0,0 +1,105 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+)
+
+func TestFormatOrdinals(t *testing.T) {
+	cases := []struct {
+		name     string
+		ordinals []int
+		expected string
+	}{
+		{name: "empty", ordinals: nil, expected: ""},
+		{name: "single value", ordinals: []int{5}, expected: "5"},
+		{name: "range and single value", ordinals: []int{1, 2, 3, 7}, expected: "1-3,7"},
+		{name: "out of order and duplicated", ordinals: []int{7, 3, 1, 2, 3}, expected: "1-3,7"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := FormatOrdinals(tc.ordinals); got != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestParseOrdinals(t *testing.T) {
+	cases := []struct {
+		name        string
+		input       string
+		expected    []int
+		expectedLen int
+		expectedErr bool
+	}{
+		{name: "empty", input: "", expected: nil},
+		{name: "range and single value", input: "1-3,7", expected: []int{1, 2, 3, 7}},
+		{name: "single value", input: "4", expected: []int{4}},
+		{name: "single value range", input: "4-4", expected: []int{4}},
+		{name: "spaces", input: " 1 - 2 , 5 ", expected: []int{1, 2, 5}},
+		{name: "overlapping and out of order", input: "7,2-4,1-3", expected: []int{1, 2, 3, 4, 7}},
+		{name: "empty item", input: "1,,2", expectedErr: true},
+		{name: "not a number", input: "a", expectedErr: true},
+		{name: "negative", input: "-1", expectedErr: true},
+		{name: "reversed range", input: "3-1", expectedErr: true},
+		{name: "too many hyphens", input: "1-2-3", expectedErr: true},
+		{name: "oversized range", input: "0-2147483647", expectedErr: true},
+		{name: "range at the limit", input: fmt.Sprintf("0-%d", maxParsedOrdinals-1), expectedLen: maxParsedOrdinals},
+		{name: "ranges exceed the limit in total", input: fmt.Sprintf("0-%d,0-1", maxParsedOrdinals-1), expectedErr: true},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := ParseOrdinals(tc.input)
+			if tc.expectedErr {
+				if err == nil {
+					t.Fatalf("expected error, got %v", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if tc.expectedLen > 0 {
+				if len(got) != tc.expectedLen {
+					t.Fatalf("expected %d ordinals, got %d", tc.expectedLen, len(got))
+				}
+				return
+			}
+			if !reflect.DeepEqual(got, tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestOrdinalsRoundTrip(t *testing.T) {
+	for _, s := range []string{"0", "1-3,7", "0-2,4-6,9,11-12"} {
+		ordinals, err := ParseOrdinals(s)
+		if err != nil {
+			t.Fatalf("failed to parse %q: %v", s, err)
+		}
+		if got := FormatOrdinals(ordinals); got != s {
+			t.Fatalf("expected %q after round trip, got %q", s, got)
+		}
+	}
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"encoding/json"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// SidecarTerminatorAnnotationKey is the annotation on pods of jobs, whose value is the json of SidecarTerminator,
+	// to declare which sidecar containers should be killed when the main containers exit.
+	SidecarTerminatorAnnotationKey = "apps.kruise.io/sidecar-terminator"
+
+	// KruiseTerminateSidecarEnv is the env that can be set to "true" on a sidecar container to mark
+	// it should be killed when the main containers exit, which is an alternative to SidecarTerminator annotation.
+	KruiseTerminateSidecarEnv = "KRUISE_TERMINATE_SIDECAR_WHEN_JOB_EXIT"
+)
+
+// SidecarTerminator declares the sidecar containers to be killed when the main containers of a job pod exit.
+type SidecarTerminator struct {
+	// SidecarContainers are the names of the sidecar containers to be killed.
+	SidecarContainers []string `json:"sidecarContainers"`
+	// MainContainers are the names of the main containers. If not specified, all containers except
+	// the sidecar containers are main containers.
+	// +optional
+	MainContainers []string `json:"mainContainers,omitempty"`
+}
+
+// IsSidecarContainer returns true if the container is declared to be killed when the main containers exit.
+func (t *SidecarTerminator) IsSidecarContainer(name string) bool {
+	for _, c := range t.SidecarContainers {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// IsMainContainer returns true if the container is a main container of the pod.
+func (t *SidecarTerminator) IsMainContainer(name string) bool {
+	if len(t.MainContainers) == 0 {
+		return !t.IsSidecarContainer(name)
+	}
+	for _, c := range t.MainContainers {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+// GetSidecarTerminator parses the SidecarTerminator from the annotation of the object,
+// and returns nil if the annotation does not exist.
+func GetSidecarTerminator(obj metav1.Object) (*SidecarTerminator, error) {
+	value, ok := obj.GetAnnotations()[SidecarTerminatorAnnotationKey]
+	if !ok {
+		return nil, nil
+	}
+	terminator := &SidecarTerminator{}
+	if err := json.Unmarshal([]byte(value), terminator); err != nil {
+		return nil, err
+	}
+	return terminator, nil
+}
+
+// SetSidecarTerminator sets the SidecarTerminator into the annotation of the object.
+func SetSidecarTerminator(obj metav1.Object, terminator *SidecarTerminator) error {
+	value, err := json.Marshal(terminator)
+	if err != nil {
+		return err
+	}
+	annotations := obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[SidecarTerminatorAnnotationKey] = string(value)
+	obj.SetAnnotations(annotations)
+	return nil
+}
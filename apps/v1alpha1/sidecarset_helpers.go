@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"sort"
+)
+
+// SplitSidecarContainersByInjectionOrder splits the sidecar containers into the ones to be injected
+// before and after the app containers by PodInjectPolicy, and each part is sorted by InjectionOrder.
+func SplitSidecarContainersByInjectionOrder(containers []SidecarContainer) (before, after []SidecarContainer) {
+	for i := range containers {
+		if containers[i].PodInjectPolicy == AfterAppContainerType {
+			after = append(after, containers[i])
+		} else {
+			before = append(before, containers[i])
+		}
+	}
+	sortSidecarContainersByInjectionOrder(before)
+	sortSidecarContainersByInjectionOrder(after)
+	return before, after
+}
+
+func sortSidecarContainersByInjectionOrder(containers []SidecarContainer) {
+	sort.SliceStable(containers, func(i, j int) bool {
+		if containers[i].InjectionOrder != containers[j].InjectionOrder {
+			return containers[i].InjectionOrder > containers[j].InjectionOrder
+		}
+		return containers[i].Name < containers[j].Name
+	})
+}
+
+// GetHotUpgradeEmptyImage returns the empty image used to hot upgrade the sidecar container, which is
+// upgradeStrategy.hotUpgradeEmptyImage of the container if set, otherwise the default one of SidecarSet.
+func (s *SidecarSet) GetHotUpgradeEmptyImage(c *SidecarContainer) string {
+	if c.UpgradeStrategy.HotUpgradeEmptyImage != "" {
+		return c.UpgradeStrategy.HotUpgradeEmptyImage
+	}
+	return s.Spec.UpdateStrategy.HotUpgradeEmptyImage
+}
+
+// GetVolumePolicy returns the effective volume policy of the sidecar container. If VolumePolicy is not
+// specified, it is converted from ShareVolumePolicy to keep the behavior of the sidecar containers created before.
+func (c *SidecarContainer) GetVolumePolicy() SidecarVolumePolicy {
+	if c.VolumePolicy != nil {
+		return *c.VolumePolicy
+	}
+	if c.ShareVolumePolicy.Type == ShareVolumePolicyEnabled {
+		return SidecarVolumePolicy{Type: ShareAllSidecarVolumePolicyType}
+	}
+	return SidecarVolumePolicy{Type: NoneSidecarVolumePolicyType}
+}
+
+// ShouldShareVolume returns true if the volume with the given name should be shared into the sidecar container.
+func (c *SidecarContainer) ShouldShareVolume(volumeName string) bool {
+	policy := c.GetVolumePolicy()
+	switch policy.Type {
+	case ShareAllSidecarVolumePolicyType:
+		return true
+	case ShareByNameSidecarVolumePolicyType:
+		for _, name := range policy.Names {
+			if name == volumeName {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// GetStatusDetail returns the status detail of the given namespace and revision, or nil if not found.
+func (s *SidecarSet) GetStatusDetail(namespace, revision string) *SidecarSetStatusDetail {
+	for i := range s.Status.Details {
+		if s.Status.Details[i].Namespace == namespace && s.Status.Details[i].Revision == revision {
+			return &s.Status.Details[i]
+		}
+	}
+	return nil
+}
+
+// IsRestartableInitContainer returns true if the init container is a sidecar-style init container,
+// which can be in-place upgraded like the sidecar containers.
+func (c *SidecarContainer) IsRestartableInitContainer() bool {
+	return c.InitContainerRestartPolicy == AlwaysSidecarInitContainerRestartPolicyType
+}
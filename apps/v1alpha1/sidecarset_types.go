@@ -31,6 +31,11 @@ type SidecarSetSpec struct {
 	// otherwise, match pods in all namespaces(in cluster)
 	Namespace string `json:"namespace,omitempty"`
 
+	// NamespaceSelector is a label query over the namespaces, sidecarSet will only match the pods
+	// in the selected namespaces. It can not be specified together with Namespace.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
 	// Containers is the list of init containers to be injected into the selected pod
 	// We will inject those containers by their name in ascending order
 	// We only inject init containers when a new pod is created, it does not apply to any existing pod
@@ -44,8 +49,51 @@ type SidecarSetSpec struct {
 
 	// The sidecarset updateStrategy to use to replace existing pods with new ones.
 	UpdateStrategy SidecarSetUpdateStrategy `json:"updateStrategy,omitempty"`
+
+	// InjectionStrategy describe the strategy when sidecarset is injected into pods
+	InjectionStrategy SidecarSetInjectionStrategy `json:"injectionStrategy,omitempty"`
+}
+
+// SidecarSetInjectionStrategy indicates the injection strategy of SidecarSet.
+type SidecarSetInjectionStrategy struct {
+	// Revision can help users rolling update SidecarSet safely. If users set
+	// this field, SidecarSet will try to inject specific revision according to
+	// different policies.
+	Revision *SidecarSetInjectRevision `json:"revision,omitempty"`
+}
+
+// SidecarSetInjectRevision pins the revision of SidecarSet to be injected into newly created pods.
+// Only one of CustomVersion and RevisionName can be set.
+type SidecarSetInjectRevision struct {
+	// CustomVersion corresponds to label 'apps.kruise.io/sidecarset-custom-version' of (History) SidecarSet.
+	// SidecarSet will select the specific ControllerRevision via this CustomVersion, and then take the
+	// most recent ControllerRevision among them.
+	CustomVersion *string `json:"customVersion,omitempty"`
+	// RevisionName corresponds to a specific ControllerRevision name of SidecarSet that you want to inject to Pods.
+	RevisionName *string `json:"revisionName,omitempty"`
+	// Policy describes the behavior of revision injection.
+	// Default is Always.
+	Policy SidecarSetInjectRevisionPolicy `json:"policy,omitempty"`
 }
 
+type SidecarSetInjectRevisionPolicy string
+
+const (
+	// AlwaysSidecarSetInjectRevisionPolicy means the SidecarSet will always inject the specific revision to Pods when pod creating.
+	AlwaysSidecarSetInjectRevisionPolicy SidecarSetInjectRevisionPolicy = "Always"
+
+	// PartialSidecarSetInjectRevisionPolicy means the SidecarSet will inject the specific revision to Pods
+	// that do not match updateStrategy.selector, and the latest revision to the ones matching it, which is
+	// useful for canary sidecar upgrades.
+	PartialSidecarSetInjectRevisionPolicy SidecarSetInjectRevisionPolicy = "Partial"
+)
+
+const (
+	// SidecarSetCustomVersionLabel is the label of SidecarSet and its ControllerRevisions recording the custom version,
+	// which can be referred by injectionStrategy.revision.customVersion.
+	SidecarSetCustomVersionLabel = "apps.kruise.io/sidecarset-custom-version"
+)
+
 // SidecarContainer defines the container of Sidecar
 type SidecarContainer struct {
 	corev1.Container `json:",inline"`
@@ -57,18 +105,63 @@ type SidecarContainer struct {
 	// default BeforeAppContainerType
 	PodInjectPolicy PodInjectPolicyType `json:"podInjectPolicy,omitempty"`
 
+	// InjectionOrder decides the order of sidecar containers injected at the same side of the app containers,
+	// which is decided by PodInjectPolicy. Containers with higher InjectionOrder are closer to the front of
+	// pod.spec.containers, and containers with the same InjectionOrder are sorted by name.
+	// Two containers with the same PodInjectPolicy can not have the same InjectionOrder, except the default 0.
+	// not takes effect in initContainers
+	InjectionOrder int32 `json:"injectionOrder,omitempty"`
+
 	// sidecarContainer upgrade strategy, include: ColdUpgrade, HotUpgrade
 	UpgradeStrategy SidecarContainerUpgradeStrategy `json:"upgradeStrategy,omitempty"`
 
 	// If ShareVolumePolicy is enabled, the sidecar container will share the other container's VolumeMounts
 	// in the pod(don't contains the injected sidecar container).
+	// It only takes effect when VolumePolicy is not specified.
 	ShareVolumePolicy ShareVolumePolicy `json:"shareVolumePolicy,omitempty"`
 
+	// VolumePolicy decides which VolumeMounts of the other containers in the pod are shared into the sidecar container.
+	// If not specified, ShareAll is used when ShareVolumePolicy is enabled, otherwise None.
+	// +optional
+	VolumePolicy *SidecarVolumePolicy `json:"volumePolicy,omitempty"`
+
 	// TransferEnv will transfer env info from other container
 	// SourceContainerName is pod.spec.container[x].name; EnvName is pod.spec.container[x].Env.name
 	TransferEnv []TransferEnvVar `json:"transferEnv,omitempty"`
+
+	// EnvMergePolicy decides how the env of sidecar container is merged, if the pod has already declared
+	// a container with the same name. Override means the env of sidecar container overrides the existing ones
+	// with the same name, and IgnoreIfExists means the existing ones are kept.
+	// default Override
+	EnvMergePolicy SidecarMergePolicyType `json:"envMergePolicy,omitempty"`
+
+	// ResourcesMergePolicy decides how the resources of sidecar container is merged, if the pod has already declared
+	// a container with the same name. Override means the resources of sidecar container overrides the existing requests
+	// and limits, and IgnoreIfExists means the existing ones are kept.
+	// default Override
+	ResourcesMergePolicy SidecarMergePolicyType `json:"resourcesMergePolicy,omitempty"`
+
+	// InitContainerRestartPolicy only takes effect in initContainers. If Always, the init container is a
+	// sidecar-style init container (aka native sidecar in KEP-753), which keeps running during the lifetime
+	// of pod, and can be in-place upgraded like the sidecar containers.
+	// +optional
+	InitContainerRestartPolicy SidecarInitContainerRestartPolicyType `json:"initContainerRestartPolicy,omitempty"`
 }
 
+type SidecarInitContainerRestartPolicyType string
+
+const (
+	// AlwaysSidecarInitContainerRestartPolicyType marks the init container as restartable and in-place upgradable.
+	AlwaysSidecarInitContainerRestartPolicyType SidecarInitContainerRestartPolicyType = "Always"
+)
+
+type SidecarMergePolicyType string
+
+const (
+	OverrideSidecarMergePolicyType       SidecarMergePolicyType = "Override"
+	IgnoreIfExistsSidecarMergePolicyType SidecarMergePolicyType = "IgnoreIfExists"
+)
+
 type ShareVolumePolicy struct {
 	Type ShareVolumePolicyType `json:"type,omitempty"`
 }
@@ -87,9 +180,41 @@ const (
 	ShareVolumePolicyDisabled ShareVolumePolicyType = "disabled"
 )
 
+// SidecarVolumePolicy defines how VolumeMounts of the other containers are shared into the sidecar container.
+type SidecarVolumePolicy struct {
+	// Type of the policy, including ShareAll, ShareByName and None.
+	Type SidecarVolumePolicyType `json:"type"`
+	// Names of the volumes to be shared, which can only be specified with ShareByName type.
+	// +optional
+	Names []string `json:"names,omitempty"`
+}
+
+type SidecarVolumePolicyType string
+
+const (
+	// ShareAllSidecarVolumePolicyType shares all VolumeMounts of the other containers into the sidecar container.
+	ShareAllSidecarVolumePolicyType SidecarVolumePolicyType = "ShareAll"
+	// ShareByNameSidecarVolumePolicyType only shares the VolumeMounts whose volumes are listed in names.
+	ShareByNameSidecarVolumePolicyType SidecarVolumePolicyType = "ShareByName"
+	// NoneSidecarVolumePolicyType shares no VolumeMount into the sidecar container.
+	NoneSidecarVolumePolicyType SidecarVolumePolicyType = "None"
+)
+
 type TransferEnvVar struct {
 	SourceContainerName string `json:"sourceContainerName,omitempty"`
-	EnvName             string `json:"envName,omitempty"`
+	// SourceFrom selects the value from the pod fields, such as labels and annotations,
+	// instead of the env of another container. It can not be specified together with SourceContainerName.
+	// +optional
+	SourceFrom *TransferEnvVarSource `json:"sourceFrom,omitempty"`
+	EnvName    string                `json:"envName,omitempty"`
+}
+
+// TransferEnvVarSource represents a source of the value of transferred env.
+type TransferEnvVarSource struct {
+	// FieldRef selects a field of the pod, supports metadata.name, metadata.namespace, metadata.uid,
+	// metadata.labels['<KEY>'], metadata.annotations['<KEY>'], spec.nodeName, spec.serviceAccountName,
+	// status.hostIP and status.podIP.
+	FieldRef *corev1.ObjectFieldSelector `json:"fieldRef,omitempty"`
 }
 
 type SidecarContainerUpgradeType string
@@ -109,6 +234,7 @@ type SidecarContainerUpgradeStrategy struct {
 	// when HotUpgrade, HotUpgradeEmptyImage is used to complete the hot upgrading process
 	// HotUpgradeEmptyImage is consistent of sidecar container in Command, Args, Liveness probe, etc.
 	// but it does no actual work.
+	// It overrides the updateStrategy.hotUpgradeEmptyImage of SidecarSet.
 	HotUpgradeEmptyImage string `json:"hotUpgradeEmptyImage,omitempty"`
 }
 
@@ -128,6 +254,8 @@ type SidecarSetUpdateStrategy struct {
 	Paused bool `json:"paused,omitempty"`
 
 	// If selector is not nil, this upgrade will only update the selected pods.
+	// It also selects the newly created pods to be injected with the latest revision
+	// if injectionStrategy.revision.policy is Partial.
 	Selector *metav1.LabelSelector `json:"selector,omitempty"`
 
 	// Partition is the desired number of pods in old revisions. It means when partition
@@ -148,6 +276,10 @@ type SidecarSetUpdateStrategy struct {
 	// - Note that pods will be scattered after priority sort. So, although priority strategy and scatter strategy can be applied together, we suggest to use either one of them.
 	// - If scatterStrategy is used, we suggest to just use one term. Otherwise, the update order can be hard to understand.
 	ScatterStrategy UpdateScatterStrategy `json:"scatterStrategy,omitempty"`
+
+	// HotUpgradeEmptyImage is the default empty image of the sidecar containers with HotUpgrade upgradeType,
+	// which can be overridden by upgradeStrategy.hotUpgradeEmptyImage of each container.
+	HotUpgradeEmptyImage string `json:"hotUpgradeEmptyImage,omitempty"`
 }
 
 type SidecarSetUpdateStrategyType string
@@ -174,6 +306,32 @@ type SidecarSetStatus struct {
 
 	// updatedReadyPods is the number of matched pods that updated and ready
 	UpdatedReadyPods int32 `json:"updatedReadyPods,omitempty"`
+
+	// LatestRevision, if not empty, indicates the latest controllerRevision name of the SidecarSet.
+	LatestRevision string `json:"latestRevision,omitempty"`
+
+	// CollisionCount is the count of hash collisions for the SidecarSet. The SidecarSet controller
+	// uses this field as a collision avoidance mechanism when it needs to create the name for the
+	// newest ControllerRevision.
+	CollisionCount *int32 `json:"collisionCount,omitempty"`
+
+	// Details is the breakdown of matched pods by namespace and by the revision of injected sidecar containers.
+	// +optional
+	Details []SidecarSetStatusDetail `json:"details,omitempty"`
+}
+
+// SidecarSetStatusDetail records the numbers of matched pods in a namespace injected with a revision of SidecarSet.
+type SidecarSetStatusDetail struct {
+	// Namespace of the matched pods.
+	Namespace string `json:"namespace"`
+	// Revision is the controllerRevision name of SidecarSet injected into the pods.
+	Revision string `json:"revision"`
+	// MatchedPods is the number of matched pods in the namespace with the revision.
+	MatchedPods int32 `json:"matchedPods"`
+	// UpdatedPods is the number of the pods that have been updated to the latest revision.
+	UpdatedPods int32 `json:"updatedPods"`
+	// ReadyPods is the number of the pods that have a ready condition.
+	ReadyPods int32 `json:"readyPods"`
 }
 
 // +genclient
@@ -0,0 +1,264 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"regexp"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateSidecarInjectionOrder checks the containers injected at the same side of app containers
+// do not have the same non-zero injectionOrder, which makes the order ambiguous.
+func ValidateSidecarInjectionOrder(spec *SidecarSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil {
+		return allErrs
+	}
+
+	containersPath := fldPath.Child("containers")
+	seen := make(map[PodInjectPolicyType]map[int32]string)
+	for i, c := range spec.Containers {
+		if c.InjectionOrder == 0 {
+			continue
+		}
+		policy := c.PodInjectPolicy
+		if policy == "" {
+			policy = BeforeAppContainerType
+		}
+		if seen[policy] == nil {
+			seen[policy] = make(map[int32]string)
+		}
+		if name, ok := seen[policy][c.InjectionOrder]; ok {
+			allErrs = append(allErrs, field.Invalid(containersPath.Index(i).Child("injectionOrder"), c.InjectionOrder,
+				fmt.Sprintf("conflicts with container %s which is also injected %s", name, policy)))
+			continue
+		}
+		seen[policy][c.InjectionOrder] = c.Name
+	}
+	return allErrs
+}
+
+// ValidateSidecarHotUpgrade checks the upgradeType of sidecar containers is valid, and the ones with HotUpgrade
+// have an empty image, either specified by themselves or by the SidecarSet, which differs from their own images.
+func ValidateSidecarHotUpgrade(spec *SidecarSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil {
+		return allErrs
+	}
+
+	containersPath := fldPath.Child("containers")
+	for i := range spec.Containers {
+		c := &spec.Containers[i]
+		upgradePath := containersPath.Index(i).Child("upgradeStrategy")
+		switch c.UpgradeStrategy.UpgradeType {
+		case "", SidecarContainerColdUpgrade:
+			continue
+		case SidecarContainerHotUpgrade:
+		default:
+			allErrs = append(allErrs, field.NotSupported(upgradePath.Child("upgradeType"), c.UpgradeStrategy.UpgradeType,
+				[]string{string(SidecarContainerColdUpgrade), string(SidecarContainerHotUpgrade)}))
+			continue
+		}
+
+		emptyImage := c.UpgradeStrategy.HotUpgradeEmptyImage
+		if emptyImage == "" {
+			emptyImage = spec.UpdateStrategy.HotUpgradeEmptyImage
+		}
+		if emptyImage == "" {
+			allErrs = append(allErrs, field.Required(upgradePath.Child("hotUpgradeEmptyImage"),
+				"either container or updateStrategy of sidecarset should specify hotUpgradeEmptyImage"))
+		} else if emptyImage == c.Image {
+			allErrs = append(allErrs, field.Invalid(upgradePath.Child("hotUpgradeEmptyImage"), emptyImage,
+				"must be different from the image of container"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateSidecarVolumePolicy checks the volumePolicy of sidecar containers, names can only and must be
+// specified with ShareByName type, and volumePolicy can not be specified together with shareVolumePolicy.
+func ValidateSidecarVolumePolicy(spec *SidecarSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil {
+		return allErrs
+	}
+
+	containersPath := fldPath.Child("containers")
+	for i := range spec.Containers {
+		c := &spec.Containers[i]
+		if c.VolumePolicy == nil {
+			continue
+		}
+		policyPath := containersPath.Index(i).Child("volumePolicy")
+		if c.ShareVolumePolicy.Type != "" {
+			allErrs = append(allErrs, field.Forbidden(policyPath, "volumePolicy and shareVolumePolicy can not be specified together"))
+		}
+
+		switch c.VolumePolicy.Type {
+		case ShareByNameSidecarVolumePolicyType:
+			if len(c.VolumePolicy.Names) == 0 {
+				allErrs = append(allErrs, field.Required(policyPath.Child("names"), "must be specified for ShareByName type"))
+			}
+			seen := sets.NewString()
+			for j, name := range c.VolumePolicy.Names {
+				if name == "" {
+					allErrs = append(allErrs, field.Required(policyPath.Child("names").Index(j), ""))
+				} else if seen.Has(name) {
+					allErrs = append(allErrs, field.Duplicate(policyPath.Child("names").Index(j), name))
+				}
+				seen.Insert(name)
+			}
+		case ShareAllSidecarVolumePolicyType, NoneSidecarVolumePolicyType:
+			if len(c.VolumePolicy.Names) > 0 {
+				allErrs = append(allErrs, field.Forbidden(policyPath.Child("names"), "only allowed for ShareByName type"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(policyPath.Child("type"), c.VolumePolicy.Type, []string{
+				string(ShareAllSidecarVolumePolicyType), string(ShareByNameSidecarVolumePolicyType), string(NoneSidecarVolumePolicyType)}))
+		}
+	}
+	return allErrs
+}
+
+// ValidateSidecarSetNamespace checks namespace and namespaceSelector are not specified together,
+// and namespaceSelector is a valid label selector.
+func ValidateSidecarSetNamespace(spec *SidecarSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.NamespaceSelector == nil {
+		return allErrs
+	}
+
+	selectorPath := fldPath.Child("namespaceSelector")
+	if spec.Namespace != "" {
+		allErrs = append(allErrs, field.Forbidden(selectorPath, "namespace and namespaceSelector are mutually exclusive"))
+	}
+	if _, err := metav1.LabelSelectorAsSelector(spec.NamespaceSelector); err != nil {
+		allErrs = append(allErrs, field.Invalid(selectorPath, spec.NamespaceSelector, err.Error()))
+	}
+	return allErrs
+}
+
+// ValidateSidecarMergePolicy checks the envMergePolicy and resourcesMergePolicy of sidecar containers.
+func ValidateSidecarMergePolicy(spec *SidecarSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil {
+		return allErrs
+	}
+
+	validPolicies := []string{string(OverrideSidecarMergePolicyType), string(IgnoreIfExistsSidecarMergePolicyType)}
+	isValid := func(policy SidecarMergePolicyType) bool {
+		return policy == "" || policy == OverrideSidecarMergePolicyType || policy == IgnoreIfExistsSidecarMergePolicyType
+	}
+	containersPath := fldPath.Child("containers")
+	for i := range spec.Containers {
+		c := &spec.Containers[i]
+		if !isValid(c.EnvMergePolicy) {
+			allErrs = append(allErrs, field.NotSupported(containersPath.Index(i).Child("envMergePolicy"), c.EnvMergePolicy, validPolicies))
+		}
+		if !isValid(c.ResourcesMergePolicy) {
+			allErrs = append(allErrs, field.NotSupported(containersPath.Index(i).Child("resourcesMergePolicy"), c.ResourcesMergePolicy, validPolicies))
+		}
+	}
+	return allErrs
+}
+
+// transferEnvFieldPaths are the pod fields that can be selected by sourceFrom.fieldRef of transferEnv.
+var transferEnvFieldPaths = sets.NewString("metadata.name", "metadata.namespace", "metadata.uid",
+	"spec.nodeName", "spec.serviceAccountName", "status.hostIP", "status.podIP")
+
+// transferEnvSubscriptedFieldPath matches the fieldPath of a label or an annotation, e.g. metadata.labels['app'].
+var transferEnvSubscriptedFieldPath = regexp.MustCompile(`^metadata\.(labels|annotations)\['([^']+)'\]$`)
+
+// ValidateSidecarTransferEnv checks each transferEnv of sidecar containers has exactly one of sourceContainerName
+// and sourceFrom, and the fieldPath of sourceFrom is supported.
+func ValidateSidecarTransferEnv(spec *SidecarSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil {
+		return allErrs
+	}
+
+	containersPath := fldPath.Child("containers")
+	for i := range spec.Containers {
+		for j, env := range spec.Containers[i].TransferEnv {
+			envPath := containersPath.Index(i).Child("transferEnv").Index(j)
+			if env.EnvName == "" {
+				allErrs = append(allErrs, field.Required(envPath.Child("envName"), ""))
+			}
+			switch {
+			case env.SourceContainerName == "" && env.SourceFrom == nil:
+				allErrs = append(allErrs, field.Required(envPath, "either sourceContainerName or sourceFrom must be specified"))
+			case env.SourceContainerName != "" && env.SourceFrom != nil:
+				allErrs = append(allErrs, field.Forbidden(envPath.Child("sourceFrom"), "can not be specified together with sourceContainerName"))
+			case env.SourceFrom != nil:
+				allErrs = append(allErrs, validateTransferEnvVarSource(env.SourceFrom, envPath.Child("sourceFrom"))...)
+			}
+		}
+	}
+	return allErrs
+}
+
+func validateTransferEnvVarSource(source *TransferEnvVarSource, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if source.FieldRef == nil {
+		return append(allErrs, field.Required(fldPath.Child("fieldRef"), ""))
+	}
+
+	fieldPath := source.FieldRef.FieldPath
+	if transferEnvFieldPaths.Has(fieldPath) {
+		return allErrs
+	}
+	if transferEnvSubscriptedFieldPath.MatchString(fieldPath) {
+		key := transferEnvSubscriptedFieldPath.FindStringSubmatch(fieldPath)[2]
+		for _, msg := range validation.IsQualifiedName(key) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("fieldRef", "fieldPath"), fieldPath, msg))
+		}
+		return allErrs
+	}
+	return append(allErrs, field.Invalid(fldPath.Child("fieldRef", "fieldPath"), fieldPath,
+		fmt.Sprintf("must be one of %v, metadata.labels['<KEY>'] or metadata.annotations['<KEY>']", transferEnvFieldPaths.List())))
+}
+
+// ValidateSidecarInitContainerRestartPolicy checks initContainerRestartPolicy is only specified in initContainers
+// with a supported value.
+func ValidateSidecarInitContainerRestartPolicy(spec *SidecarSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil {
+		return allErrs
+	}
+
+	for i := range spec.InitContainers {
+		c := &spec.InitContainers[i]
+		switch c.InitContainerRestartPolicy {
+		case "", AlwaysSidecarInitContainerRestartPolicyType:
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("initContainers").Index(i).Child("initContainerRestartPolicy"),
+				c.InitContainerRestartPolicy, []string{string(AlwaysSidecarInitContainerRestartPolicyType)}))
+		}
+	}
+	for i := range spec.Containers {
+		if spec.Containers[i].InitContainerRestartPolicy != "" {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("containers").Index(i).Child("initContainerRestartPolicy"),
+				"only allowed in initContainers"))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,46 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// IsSpecifiedDelete returns true if the object has been marked to be deleted by SpecifiedDeleteKey label.
+func IsSpecifiedDelete(obj metav1.Object) bool {
+	_, ok := obj.GetLabels()[SpecifiedDeleteKey]
+	return ok
+}
+
+// SpecifiedDelete marks the object to be deleted by adding SpecifiedDeleteKey label, and returns
+// false if it has already been marked. For CloneSet pods, the controller will delete the pod, or
+// move it into PreparingDelete lifecycle state first if the PreDelete hook is set, and create a new one
+// if replicas is unchanged.
+// The object should be updated or patched by callers, such as with
+// `{"metadata":{"labels":{"apps.kruise.io/specified-delete":"true"}}}`.
+func SpecifiedDelete(obj metav1.Object) bool {
+	if IsSpecifiedDelete(obj) {
+		return false
+	}
+	labels := obj.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	labels[SpecifiedDeleteKey] = "true"
+	obj.SetLabels(labels)
+	return true
+}
@@ -0,0 +1,108 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"sort"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// MergeStatefulSetConditions merges the updated conditions into the existing ones, which is the batch
+// version of SetStatefulSetCondition.
+// LastTransitionTime of a condition is preserved if its status is unchanged, otherwise it will be set to now.
+// Existing conditions not in updates are kept, and the result is sorted by condition type.
+func MergeStatefulSetConditions(existing []apps.StatefulSetCondition, updates []apps.StatefulSetCondition) []apps.StatefulSetCondition {
+	now := metav1.Now()
+	merged := make(map[apps.StatefulSetConditionType]apps.StatefulSetCondition, len(existing)+len(updates))
+	for _, c := range existing {
+		merged[c.Type] = c
+	}
+	for _, c := range updates {
+		if old, ok := merged[c.Type]; ok && old.Status == c.Status {
+			c.LastTransitionTime = old.LastTransitionTime
+		} else {
+			c.LastTransitionTime = now
+		}
+		merged[c.Type] = c
+	}
+
+	conditions := make([]apps.StatefulSetCondition, 0, len(merged))
+	for _, c := range merged {
+		conditions = append(conditions, c)
+	}
+	sort.Slice(conditions, func(i, j int) bool {
+		return conditions[i].Type < conditions[j].Type
+	})
+	return conditions
+}
+
+// NewStatefulSetCondition creates a new StatefulSet condition.
+func NewStatefulSetCondition(condType apps.StatefulSetConditionType, status v1.ConditionStatus, reason, message string) apps.StatefulSetCondition {
+	return apps.StatefulSetCondition{
+		Type:               condType,
+		Status:             status,
+		LastTransitionTime: metav1.Now(),
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// GetStatefulSetCondition returns the condition with the provided type.
+func GetStatefulSetCondition(status StatefulSetStatus, condType apps.StatefulSetConditionType) *apps.StatefulSetCondition {
+	for i := range status.Conditions {
+		c := status.Conditions[i]
+		if c.Type == condType {
+			return &c
+		}
+	}
+	return nil
+}
+
+// SetStatefulSetCondition updates the StatefulSet to include the provided condition. If the condition that
+// we are about to add already exists and has the same status and reason, then we are not going to update it.
+func SetStatefulSetCondition(status *StatefulSetStatus, condition apps.StatefulSetCondition) {
+	currentCond := GetStatefulSetCondition(*status, condition.Type)
+	if currentCond != nil && currentCond.Status == condition.Status && currentCond.Reason == condition.Reason {
+		return
+	}
+	// Do not update lastTransitionTime if the status of the condition doesn't change.
+	if currentCond != nil && currentCond.Status == condition.Status {
+		condition.LastTransitionTime = currentCond.LastTransitionTime
+	}
+	newConditions := filterOutStatefulSetCondition(status.Conditions, condition.Type)
+	status.Conditions = append(newConditions, condition)
+}
+
+// RemoveStatefulSetCondition removes the StatefulSet condition with the provided type.
+func RemoveStatefulSetCondition(status *StatefulSetStatus, condType apps.StatefulSetConditionType) {
+	status.Conditions = filterOutStatefulSetCondition(status.Conditions, condType)
+}
+
+// filterOutStatefulSetCondition returns a new slice of StatefulSet conditions without conditions with the provided type.
+func filterOutStatefulSetCondition(conditions []apps.StatefulSetCondition, condType apps.StatefulSetConditionType) []apps.StatefulSetCondition {
+	var newConditions []apps.StatefulSetCondition
+	for _, c := range conditions {
+		if c.Type == condType {
+			continue
+		}
+		newConditions = append(newConditions, c)
+	}
+	return newConditions
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+	"time"
+
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestMergeStatefulSetConditions(t *testing.T) {
+	oldTime := metav1.NewTime(time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC))
+	existing := []apps.StatefulSetCondition{
+		{Type: "Foo", Status: v1.ConditionTrue, LastTransitionTime: oldTime, Reason: "Old"},
+		{Type: "Kept", Status: v1.ConditionTrue, LastTransitionTime: oldTime},
+	}
+	cases := []struct {
+		name          string
+		update        apps.StatefulSetCondition
+		expectedTypes []apps.StatefulSetConditionType
+		expectOldTime bool
+	}{
+		{
+			name:          "new condition",
+			update:        apps.StatefulSetCondition{Type: "Bar", Status: v1.ConditionTrue},
+			expectedTypes: []apps.StatefulSetConditionType{"Bar", "Foo", "Kept"},
+		},
+		{
+			name:          "changed status",
+			update:        apps.StatefulSetCondition{Type: "Foo", Status: v1.ConditionFalse, Reason: "New"},
+			expectedTypes: []apps.StatefulSetConditionType{"Foo", "Kept"},
+		},
+		{
+			name:          "unchanged status",
+			update:        apps.StatefulSetCondition{Type: "Foo", Status: v1.ConditionTrue, Reason: "New"},
+			expectedTypes: []apps.StatefulSetConditionType{"Foo", "Kept"},
+			expectOldTime: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			merged := MergeStatefulSetConditions(existing, []apps.StatefulSetCondition{tc.update})
+			if len(merged) != len(tc.expectedTypes) {
+				t.Fatalf("expected %d conditions, got %v", len(tc.expectedTypes), merged)
+			}
+			for i, c := range merged {
+				if c.Type != tc.expectedTypes[i] {
+					t.Fatalf("expected condition %s at %d, got %s", tc.expectedTypes[i], i, c.Type)
+				}
+				switch c.Type {
+				case tc.update.Type:
+					if c.Status != tc.update.Status || c.Reason != tc.update.Reason {
+						t.Errorf("expected condition %v, got %v", tc.update, c)
+					}
+					if preserved := c.LastTransitionTime.Equal(&oldTime); preserved != tc.expectOldTime {
+						t.Errorf("expected lastTransitionTime preserved %v, got %v", tc.expectOldTime, c.LastTransitionTime)
+					}
+					if c.LastTransitionTime.IsZero() {
+						t.Errorf("expected lastTransitionTime to be set")
+					}
+				default:
+					if !c.LastTransitionTime.Equal(&oldTime) {
+						t.Errorf("expected lastTransitionTime of %s to be kept, got %v", c.Type, c.LastTransitionTime)
+					}
+				}
+			}
+		})
+	}
+}
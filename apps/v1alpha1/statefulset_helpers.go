@@ -0,0 +1,360 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+	"strings"
+
+	appspub "github.com/openkruise/kruise-api/apps/pub"
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+const (
+	// PartitionSemanticsOrdinalThreshold means pods with ordinal less than partition will not be updated.
+	PartitionSemanticsOrdinalThreshold = "ordinal-threshold"
+	// PartitionSemanticsNonUpdatedCount means partition is the number of pods kept in non-updated revisions,
+	// which takes effect when unorderedUpdate is set.
+	PartitionSemanticsNonUpdatedCount = "non-updated-count"
+)
+
+// maxRevisionNamePrefixLength is the max length of name prefix for ControllerRevision,
+// which makes sure the full name will not exceed the limit of 253 characters.
+const maxRevisionNamePrefixLength = 223
+
+// NormalizedTemplate returns a deep copy of the pod template with the common defaults
+// of kube-apiserver filled, so that two templates which only differ by unset and
+// explicitly defaulted fields are semantically equal.
+// The upstream SetObjectDefaults_PodTemplateSpec lives in k8s.io/kubernetes/pkg/apis/core/v1,
+// which can not be imported here since k8s.io/kubernetes is not meant to be a library dependency.
+// So the defaults of PodSpec and Container that are commonly left unset are mirrored instead,
+// and they should be kept in sync with SetDefaults_PodSpec and SetDefaults_Container there.
+func (s *StatefulSetSpec) NormalizedTemplate() *v1.PodTemplateSpec {
+	template := s.Template.DeepCopy()
+	spec := &template.Spec
+
+	if spec.RestartPolicy == "" {
+		spec.RestartPolicy = v1.RestartPolicyAlways
+	}
+	if spec.DNSPolicy == "" {
+		spec.DNSPolicy = v1.DNSClusterFirst
+	}
+	if spec.SchedulerName == "" {
+		spec.SchedulerName = v1.DefaultSchedulerName
+	}
+	if spec.TerminationGracePeriodSeconds == nil {
+		period := int64(v1.DefaultTerminationGracePeriodSeconds)
+		spec.TerminationGracePeriodSeconds = &period
+	}
+	if spec.SecurityContext == nil {
+		spec.SecurityContext = &v1.PodSecurityContext{}
+	}
+	for i := range spec.InitContainers {
+		normalizeContainer(&spec.InitContainers[i])
+	}
+	for i := range spec.Containers {
+		normalizeContainer(&spec.Containers[i])
+	}
+	return template
+}
+
+func normalizeContainer(c *v1.Container) {
+	if c.ImagePullPolicy == "" {
+		if imageTag(c.Image) == "latest" {
+			c.ImagePullPolicy = v1.PullAlways
+		} else {
+			c.ImagePullPolicy = v1.PullIfNotPresent
+		}
+	}
+	if c.TerminationMessagePath == "" {
+		c.TerminationMessagePath = v1.TerminationMessagePathDefault
+	}
+	if c.TerminationMessagePolicy == "" {
+		c.TerminationMessagePolicy = v1.TerminationMessageReadFile
+	}
+	for i := range c.Ports {
+		if c.Ports[i].Protocol == "" {
+			c.Ports[i].Protocol = v1.ProtocolTCP
+		}
+	}
+	normalizeProbe(c.LivenessProbe)
+	normalizeProbe(c.ReadinessProbe)
+}
+
+func normalizeProbe(p *v1.Probe) {
+	if p == nil {
+		return
+	}
+	if p.TimeoutSeconds == 0 {
+		p.TimeoutSeconds = 1
+	}
+	if p.PeriodSeconds == 0 {
+		p.PeriodSeconds = 10
+	}
+	if p.SuccessThreshold == 0 {
+		p.SuccessThreshold = 1
+	}
+	if p.FailureThreshold == 0 {
+		p.FailureThreshold = 3
+	}
+	if p.HTTPGet != nil {
+		if p.HTTPGet.Path == "" {
+			p.HTTPGet.Path = "/"
+		}
+		if p.HTTPGet.Scheme == "" {
+			p.HTTPGet.Scheme = v1.URISchemeHTTP
+		}
+	}
+}
+
+// imageTag returns the tag of the image, or "latest" if neither tag nor digest is specified.
+func imageTag(image string) string {
+	if strings.Contains(image, "@") {
+		return ""
+	}
+	name := image
+	if i := strings.LastIndex(image, "/"); i >= 0 {
+		name = image[i+1:]
+	}
+	if i := strings.LastIndex(name, ":"); i >= 0 {
+		return name[i+1:]
+	}
+	return "latest"
+}
+
+// DetectVolumeNameConflicts returns the names which appear both in VolumeClaimTemplates and
+// in the volumes of pod template. Note that the claim takes precedence over the volume with
+// the same name, so tooling may warn users about the shadowed volumes.
+func DetectVolumeNameConflicts(spec *StatefulSetSpec) []string {
+	if spec == nil || len(spec.VolumeClaimTemplates) == 0 || len(spec.Template.Spec.Volumes) == 0 {
+		return nil
+	}
+
+	volumeNames := make(map[string]struct{}, len(spec.Template.Spec.Volumes))
+	for _, v := range spec.Template.Spec.Volumes {
+		volumeNames[v.Name] = struct{}{}
+	}
+
+	var conflicts []string
+	for _, claim := range spec.VolumeClaimTemplates {
+		if _, ok := volumeNames[claim.Name]; ok {
+			conflicts = append(conflicts, claim.Name)
+		}
+	}
+	return conflicts
+}
+
+// RevisionName returns the canonical name of ControllerRevision, which is $(name)-$(revisionHash) with the
+// name truncated to keep the result valid, the same as ControllerRevisionName of the controller history.
+// The revisionHash is used as is, so it must be the one computed by the controller's HashControllerRevision
+// with Status.CollisionCount as the probe, which already makes the name unique across collisions.
+func (s *StatefulSet) RevisionName(revisionHash string) string {
+	prefix := s.Name
+	if len(prefix) > maxRevisionNamePrefixLength {
+		prefix = prefix[:maxRevisionNamePrefixLength]
+	}
+	return fmt.Sprintf("%s-%s", prefix, revisionHash)
+}
+
+// BumpCollisionCount increases Status.CollisionCount by one, allocating it if it is nil.
+func (s *StatefulSet) BumpCollisionCount() {
+	if s.Status.CollisionCount == nil {
+		s.Status.CollisionCount = new(int32)
+	}
+	*s.Status.CollisionCount++
+}
+
+var _ appspub.ScalableWorkload = &StatefulSet{}
+
+// GetReplicas returns the desired replicas of StatefulSet, which defaults to 1.
+func (s *StatefulSet) GetReplicas() int32 {
+	if s.Spec.Replicas == nil {
+		return 1
+	}
+	return *s.Spec.Replicas
+}
+
+// SetReplicas sets the desired replicas of StatefulSet.
+func (s *StatefulSet) SetReplicas(replicas int32) {
+	s.Spec.Replicas = &replicas
+}
+
+// GetSelector returns the label selector of StatefulSet.
+func (s *StatefulSet) GetSelector() *metav1.LabelSelector {
+	return s.Spec.Selector
+}
+
+// GetStatusReplicas returns the number of pods created by StatefulSet controller.
+func (s *StatefulSet) GetStatusReplicas() int32 {
+	return s.Status.Replicas
+}
+
+// RequiresHeadlessService returns true if the StatefulSet has a governing service.
+// Note that it can not tell whether the service is headless (ClusterIP None),
+// callers must verify that out-of-band.
+func (s *StatefulSet) RequiresHeadlessService() bool {
+	return s.Spec.ServiceName != ""
+}
+
+// HasStableNetworkIdentity returns true if pods of the StatefulSet will get stable
+// DNS/hostnames from the governing service.
+// Like RequiresHeadlessService, callers must verify the service is headless out-of-band.
+func (s *StatefulSet) HasStableNetworkIdentity() bool {
+	return s.RequiresHeadlessService()
+}
+
+// RemainingUpdates returns the number of pods that still need to be updated to the update revision.
+// For ordered update, pods with ordinal in [partition, replicas) should be updated;
+// for unordered update, partition means the number of pods kept in non-updated revisions.
+// Both of them expect (replicas - partition) updated pods. The result will not be negative.
+func (s *StatefulSet) RemainingUpdates() int32 {
+	replicas := s.GetReplicas()
+	var partition int32
+	if s.Spec.UpdateStrategy.RollingUpdate != nil && s.Spec.UpdateStrategy.RollingUpdate.Partition != nil {
+		partition = *s.Spec.UpdateStrategy.RollingUpdate.Partition
+	}
+	if partition > replicas {
+		partition = replicas
+	}
+
+	remaining := replicas - partition - s.Status.UpdatedReplicas
+	if remaining < 0 {
+		return 0
+	}
+	return remaining
+}
+
+// TotalReplicas sums the replicas of all StatefulSets in the list.
+// The desired replicas of each StatefulSet defaults to 1 if Spec.Replicas is nil.
+func (l *StatefulSetList) TotalReplicas() (desired, current, ready, available, updated int32) {
+	for i := range l.Items {
+		s := &l.Items[i]
+		desired += s.GetReplicas()
+		current += s.Status.Replicas
+		ready += s.Status.ReadyReplicas
+		available += s.Status.AvailableReplicas
+		updated += s.Status.UpdatedReplicas
+	}
+	return
+}
+
+// ResolveMaxUnavailable returns the absolute number of MaxUnavailable against the given replicas.
+// Percentage is calculated by rounding down, and it defaults to 1 if MaxUnavailable is not set.
+func (r *RollingUpdateStatefulSetStrategy) ResolveMaxUnavailable(replicas int32) (int32, error) {
+	if r == nil || r.MaxUnavailable == nil {
+		return 1, nil
+	}
+	maxUnavailable, err := intstr.GetValueFromIntOrPercent(r.MaxUnavailable, int(replicas), false)
+	if err != nil {
+		return 0, err
+	}
+	return int32(maxUnavailable), nil
+}
+
+// BlocksRecreateUpdates returns true if MaxUnavailable is resolved to 0 against the given replicas,
+// which means no pod can ever be taken down and recreate-based updates will be stalled.
+func (r *RollingUpdateStatefulSetStrategy) BlocksRecreateUpdates(replicas int32) bool {
+	maxUnavailable, err := r.ResolveMaxUnavailable(replicas)
+	return err == nil && maxUnavailable == 0
+}
+
+// String returns a concise identifier of the StatefulSet for logging, such as
+// "default/foo (rev=foo-7d8f9c, replicas=2/3)".
+func (s *StatefulSet) String() string {
+	return fmt.Sprintf("%s/%s (rev=%s, replicas=%d/%d)",
+		s.Namespace, s.Name, s.Status.UpdateRevision, s.Status.ReadyReplicas, s.GetReplicas())
+}
+
+// ChangeRequiresNewRevision returns true if the change from oldSet to newSet bumps the update revision.
+// Like the revision hash computed by the controller, only the pod template and volumeClaimTemplates
+// are compared, so changes of replicas, update strategy and the others never require a new revision.
+// It returns true if exactly one of them is nil.
+func ChangeRequiresNewRevision(oldSet, newSet *StatefulSet) bool {
+	if oldSet == nil || newSet == nil {
+		return oldSet != newSet
+	}
+	return !apiequality.Semantic.DeepEqual(oldSet.Spec.Template, newSet.Spec.Template) ||
+		!apiequality.Semantic.DeepEqual(oldSet.Spec.VolumeClaimTemplates, newSet.Spec.VolumeClaimTemplates)
+}
+
+// ClampMinReadySeconds returns a new pointer to the value clamped to [0, MaxMinReadySeconds],
+// and nil if v is nil. It is a lenient alternative to rejecting out-of-range MinReadySeconds.
+func ClampMinReadySeconds(v *int32) *int32 {
+	if v == nil {
+		return nil
+	}
+	clamped := *v
+	if clamped < 0 {
+		clamped = 0
+	} else if clamped > MaxMinReadySeconds {
+		clamped = MaxMinReadySeconds
+	}
+	return &clamped
+}
+
+// PartitionSemantics returns how Partition in rolling update strategy is interpreted,
+// so that UIs can label the field correctly.
+func (s *StatefulSetSpec) PartitionSemantics() string {
+	if s.UpdateStrategy.RollingUpdate != nil && s.UpdateStrategy.RollingUpdate.UnorderedUpdate != nil {
+		return PartitionSemanticsNonUpdatedCount
+	}
+	return PartitionSemanticsOrdinalThreshold
+}
+
+// StatefulSetFromDeployment generates a StatefulSet scaffold from the native Deployment for migration.
+// It maps replicas, selector, template and revisionHistoryLimit, uses the given serviceName and
+// leaves VolumeClaimTemplates empty. PodManagementPolicy is set to Parallel to match the
+// non-ordered behavior of Deployment.
+func StatefulSetFromDeployment(d *apps.Deployment, serviceName string) *StatefulSet {
+	d = d.DeepCopy()
+	set := &StatefulSet{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: SchemeGroupVersion.String(),
+			Kind:       "StatefulSet",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        d.Name,
+			Namespace:   d.Namespace,
+			Labels:      d.Labels,
+			Annotations: d.Annotations,
+		},
+		Spec: StatefulSetSpec{
+			Replicas:            d.Spec.Replicas,
+			Selector:            d.Spec.Selector,
+			Template:            d.Spec.Template,
+			ServiceName:         serviceName,
+			PodManagementPolicy: apps.ParallelPodManagement,
+			UpdateStrategy: StatefulSetUpdateStrategy{
+				Type:          apps.RollingUpdateStatefulSetStrategyType,
+				RollingUpdate: &RollingUpdateStatefulSetStrategy{},
+			},
+			RevisionHistoryLimit: d.Spec.RevisionHistoryLimit,
+		},
+	}
+
+	if d.Spec.Strategy.RollingUpdate != nil {
+		set.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable = d.Spec.Strategy.RollingUpdate.MaxUnavailable
+	}
+	if d.Spec.MinReadySeconds > 0 {
+		set.Spec.UpdateStrategy.RollingUpdate.MinReadySeconds = ClampMinReadySeconds(&d.Spec.MinReadySeconds)
+	}
+	return set
+}
@@ -0,0 +1,558 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"strings"
+	"testing"
+
+	appspub "github.com/openkruise/kruise-api/apps/pub"
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func TestNormalizedTemplate(t *testing.T) {
+	cases := []struct {
+		name           string
+		container      v1.Container
+		otherContainer v1.Container
+		expectEqual    bool
+	}{
+		{
+			name:           "imagePullPolicy omitted and defaulted explicitly",
+			container:      v1.Container{Name: "main", Image: "nginx:1.19"},
+			otherContainer: v1.Container{Name: "main", Image: "nginx:1.19", ImagePullPolicy: v1.PullIfNotPresent},
+			expectEqual:    true,
+		},
+		{
+			name:           "imagePullPolicy of latest image omitted and defaulted explicitly",
+			container:      v1.Container{Name: "main", Image: "nginx"},
+			otherContainer: v1.Container{Name: "main", Image: "nginx", ImagePullPolicy: v1.PullAlways},
+			expectEqual:    true,
+		},
+		{
+			name: "probe defaults omitted and defaulted explicitly",
+			container: v1.Container{Name: "main", Image: "nginx:1.19", ReadinessProbe: &v1.Probe{
+				Handler: v1.Handler{HTTPGet: &v1.HTTPGetAction{Port: intstr.FromInt(80)}},
+			}},
+			otherContainer: v1.Container{Name: "main", Image: "nginx:1.19", ReadinessProbe: &v1.Probe{
+				Handler:          v1.Handler{HTTPGet: &v1.HTTPGetAction{Path: "/", Port: intstr.FromInt(80), Scheme: v1.URISchemeHTTP}},
+				TimeoutSeconds:   1,
+				PeriodSeconds:    10,
+				SuccessThreshold: 1,
+				FailureThreshold: 3,
+			}},
+			expectEqual: true,
+		},
+		{
+			name:           "imagePullPolicy omitted and set to non-default",
+			container:      v1.Container{Name: "main", Image: "nginx:1.19"},
+			otherContainer: v1.Container{Name: "main", Image: "nginx:1.19", ImagePullPolicy: v1.PullAlways},
+			expectEqual:    false,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &StatefulSetSpec{Template: v1.PodTemplateSpec{Spec: v1.PodSpec{
+				Containers: []v1.Container{tc.container},
+			}}}
+			period := int64(v1.DefaultTerminationGracePeriodSeconds)
+			other := &StatefulSetSpec{Template: v1.PodTemplateSpec{Spec: v1.PodSpec{
+				Containers:                    []v1.Container{tc.otherContainer},
+				TerminationGracePeriodSeconds: &period,
+			}}}
+
+			got := apiequality.Semantic.DeepEqual(spec.NormalizedTemplate(), other.NormalizedTemplate())
+			if got != tc.expectEqual {
+				t.Fatalf("expected equal %v, got %v", tc.expectEqual, got)
+			}
+			if spec.Template.Spec.Containers[0].ImagePullPolicy != tc.container.ImagePullPolicy {
+				t.Fatalf("expected the original template not to be modified")
+			}
+		})
+	}
+}
+
+func TestRevisionName(t *testing.T) {
+	collisionCount := int32(2)
+	cases := []struct {
+		name           string
+		setName        string
+		collisionCount *int32
+		expected       string
+	}{
+		{name: "nil collision count", setName: "foo", expected: "foo-7d8f9c"},
+		{name: "non-nil collision count", setName: "foo", collisionCount: &collisionCount, expected: "foo-7d8f9c"},
+		{name: "long name", setName: strings.Repeat("a", 300), expected: strings.Repeat("a", maxRevisionNamePrefixLength) + "-7d8f9c"},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			set := &StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Name: tc.setName},
+				Status:     StatefulSetStatus{CollisionCount: tc.collisionCount},
+			}
+			// The hash is expected to include the collision count already, so the name only depends on it.
+			if got := set.RevisionName("7d8f9c"); got != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestBumpCollisionCount(t *testing.T) {
+	collisionCount := int32(2)
+	cases := []struct {
+		name           string
+		collisionCount *int32
+		expected       int32
+	}{
+		{name: "nil collision count", expected: 1},
+		{name: "non-nil collision count", collisionCount: &collisionCount, expected: 3},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			set := &StatefulSet{Status: StatefulSetStatus{CollisionCount: tc.collisionCount}}
+			set.BumpCollisionCount()
+			if set.Status.CollisionCount == nil || *set.Status.CollisionCount != tc.expected {
+				t.Fatalf("expected collision count %d, got %v", tc.expected, set.Status.CollisionCount)
+			}
+		})
+	}
+}
+
+func TestDetectVolumeNameConflicts(t *testing.T) {
+	cases := []struct {
+		name     string
+		claims   []string
+		volumes  []string
+		expected []string
+	}{
+		{name: "conflict", claims: []string{"data", "logs"}, volumes: []string{"config", "data"}, expected: []string{"data"}},
+		{name: "no conflict", claims: []string{"data"}, volumes: []string{"config"}},
+		{name: "no claims", volumes: []string{"data"}},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &StatefulSetSpec{}
+			for _, name := range tc.claims {
+				spec.VolumeClaimTemplates = append(spec.VolumeClaimTemplates, v1.PersistentVolumeClaim{ObjectMeta: metav1.ObjectMeta{Name: name}})
+			}
+			for _, name := range tc.volumes {
+				spec.Template.Spec.Volumes = append(spec.Template.Spec.Volumes, v1.Volume{Name: name})
+			}
+			if got := DetectVolumeNameConflicts(spec); !apiequality.Semantic.DeepEqual(got, tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestScalableWorkload(t *testing.T) {
+	selector := &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}
+	cases := []struct {
+		name     string
+		workload appspub.ScalableWorkload
+	}{
+		{
+			name: "StatefulSet",
+			workload: &StatefulSet{
+				Spec:   StatefulSetSpec{Selector: selector},
+				Status: StatefulSetStatus{Replicas: 2},
+			},
+		},
+		{
+			name: "CloneSet",
+			workload: &CloneSet{
+				Spec:   CloneSetSpec{Selector: selector},
+				Status: CloneSetStatus{Replicas: 2},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			w := tc.workload
+			if got := w.GetReplicas(); got != 1 {
+				t.Fatalf("expected default replicas 1, got %d", got)
+			}
+			w.SetReplicas(5)
+			if got := w.GetReplicas(); got != 5 {
+				t.Fatalf("expected replicas 5, got %d", got)
+			}
+			if got := w.GetSelector(); got != selector {
+				t.Fatalf("expected selector %v, got %v", selector, got)
+			}
+			if got := w.GetStatusReplicas(); got != 2 {
+				t.Fatalf("expected status replicas 2, got %d", got)
+			}
+		})
+	}
+}
+
+func TestRequiresHeadlessService(t *testing.T) {
+	cases := []struct {
+		name        string
+		serviceName string
+		expected    bool
+	}{
+		{name: "serviceName set", serviceName: "foo-headless", expected: true},
+		{name: "serviceName unset", expected: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			set := &StatefulSet{Spec: StatefulSetSpec{ServiceName: tc.serviceName}}
+			if got := set.RequiresHeadlessService(); got != tc.expected {
+				t.Fatalf("expected RequiresHeadlessService %v, got %v", tc.expected, got)
+			}
+			if got := set.HasStableNetworkIdentity(); got != tc.expected {
+				t.Fatalf("expected HasStableNetworkIdentity %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestRemainingUpdates(t *testing.T) {
+	cases := []struct {
+		name            string
+		replicas        *int32
+		partition       *int32
+		unordered       bool
+		updatedReplicas int32
+		expected        int32
+	}{
+		{name: "no partition", replicas: int32Ptr(5), updatedReplicas: 2, expected: 3},
+		{name: "ordered partitioned", replicas: int32Ptr(5), partition: int32Ptr(2), updatedReplicas: 1, expected: 2},
+		{name: "unordered partitioned", replicas: int32Ptr(5), partition: int32Ptr(2), unordered: true, updatedReplicas: 1, expected: 2},
+		{name: "partition greater than replicas", replicas: int32Ptr(3), partition: int32Ptr(5), expected: 0},
+		{name: "more updated than expected", replicas: int32Ptr(5), partition: int32Ptr(2), updatedReplicas: 4, expected: 0},
+		{name: "nil replicas", expected: 1},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rollingUpdate := &RollingUpdateStatefulSetStrategy{Partition: tc.partition}
+			if tc.unordered {
+				rollingUpdate.UnorderedUpdate = &UnorderedUpdateStrategy{}
+			}
+			set := &StatefulSet{
+				Spec: StatefulSetSpec{
+					Replicas:       tc.replicas,
+					UpdateStrategy: StatefulSetUpdateStrategy{RollingUpdate: rollingUpdate},
+				},
+				Status: StatefulSetStatus{UpdatedReplicas: tc.updatedReplicas},
+			}
+			if got := set.RemainingUpdates(); got != tc.expected {
+				t.Fatalf("expected %d, got %d", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestTotalReplicas(t *testing.T) {
+	cases := []struct {
+		name     string
+		items    []StatefulSet
+		expected [5]int32
+	}{
+		{name: "empty list"},
+		{
+			name: "several items",
+			items: []StatefulSet{
+				{
+					Spec:   StatefulSetSpec{Replicas: int32Ptr(3)},
+					Status: StatefulSetStatus{Replicas: 3, ReadyReplicas: 2, AvailableReplicas: 2, UpdatedReplicas: 1},
+				},
+				{
+					Spec:   StatefulSetSpec{Replicas: int32Ptr(0)},
+					Status: StatefulSetStatus{Replicas: 1},
+				},
+				{
+					// nil replicas defaults to 1
+					Status: StatefulSetStatus{Replicas: 1, ReadyReplicas: 1, AvailableReplicas: 1, UpdatedReplicas: 1},
+				},
+			},
+			expected: [5]int32{4, 5, 3, 3, 2},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			list := &StatefulSetList{Items: tc.items}
+			desired, current, ready, available, updated := list.TotalReplicas()
+			if got := [5]int32{desired, current, ready, available, updated}; got != tc.expected {
+				t.Fatalf("expected (desired, current, ready, available, updated) %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestBlocksRecreateUpdates(t *testing.T) {
+	cases := []struct {
+		name           string
+		maxUnavailable *intstr.IntOrString
+		replicas       int32
+		expected       bool
+	}{
+		{name: "0", maxUnavailable: intOrStrPtr(intstr.FromInt(0)), replicas: 5, expected: true},
+		{name: "0%", maxUnavailable: intOrStrPtr(intstr.FromString("0%")), replicas: 5, expected: true},
+		{name: "percent rounded down to 0", maxUnavailable: intOrStrPtr(intstr.FromString("10%")), replicas: 5, expected: true},
+		{name: "normal value", maxUnavailable: intOrStrPtr(intstr.FromString("20%")), replicas: 5, expected: false},
+		{name: "unset", replicas: 5, expected: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &RollingUpdateStatefulSetStrategy{MaxUnavailable: tc.maxUnavailable}
+			if got := r.BlocksRecreateUpdates(tc.replicas); got != tc.expected {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestStatefulSetString(t *testing.T) {
+	cases := []struct {
+		name     string
+		set      *StatefulSet
+		expected string
+	}{
+		{
+			name: "sample object",
+			set: &StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"},
+				Spec:       StatefulSetSpec{Replicas: int32Ptr(3)},
+				Status:     StatefulSetStatus{UpdateRevision: "foo-7d8f9c", ReadyReplicas: 2},
+			},
+			expected: "default/foo (rev=foo-7d8f9c, replicas=2/3)",
+		},
+		{
+			name: "nil replicas",
+			set: &StatefulSet{
+				ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo"},
+			},
+			expected: "default/foo (rev=, replicas=0/1)",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.set.String(); got != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestChangeRequiresNewRevision(t *testing.T) {
+	cases := []struct {
+		name     string
+		mutate   func(set *StatefulSet)
+		expected bool
+	}{
+		{
+			name:     "no change",
+			mutate:   func(set *StatefulSet) {},
+			expected: false,
+		},
+		{
+			name:     "replica-only change",
+			mutate:   func(set *StatefulSet) { set.Spec.Replicas = int32Ptr(5) },
+			expected: false,
+		},
+		{
+			name:     "partition-only change",
+			mutate:   func(set *StatefulSet) { set.Spec.UpdateStrategy.RollingUpdate.Partition = int32Ptr(2) },
+			expected: false,
+		},
+		{
+			name: "paused and revisionHistoryLimit change",
+			mutate: func(set *StatefulSet) {
+				set.Spec.UpdateStrategy.RollingUpdate.Paused = true
+				set.Spec.RevisionHistoryLimit = int32Ptr(3)
+			},
+			expected: false,
+		},
+		{
+			name:     "image change",
+			mutate:   func(set *StatefulSet) { set.Spec.Template.Spec.Containers[0].Image = "nginx:1.20" },
+			expected: true,
+		},
+		{
+			name: "update strategy change",
+			mutate: func(set *StatefulSet) {
+				set.Spec.UpdateStrategy.RollingUpdate.PodUpdatePolicy = InPlaceIfPossiblePodUpdateStrategyType
+				set.Spec.UpdateStrategy.RollingUpdate.MaxUnavailable = intOrStrPtr(intstr.FromInt(2))
+				set.Spec.UpdateStrategy.RollingUpdate.MinReadySeconds = int32Ptr(10)
+			},
+			expected: false,
+		},
+		{
+			name: "volumeClaimTemplates change",
+			mutate: func(set *StatefulSet) {
+				set.Spec.VolumeClaimTemplates = []v1.PersistentVolumeClaim{{ObjectMeta: metav1.ObjectMeta{Name: "data"}}}
+			},
+			expected: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			oldSet := &StatefulSet{Spec: StatefulSetSpec{
+				Replicas:       int32Ptr(3),
+				Template:       v1.PodTemplateSpec{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "main", Image: "nginx:1.19"}}}},
+				UpdateStrategy: StatefulSetUpdateStrategy{RollingUpdate: &RollingUpdateStatefulSetStrategy{Partition: int32Ptr(0)}},
+			}}
+			newSet := oldSet.DeepCopy()
+			tc.mutate(newSet)
+			if got := ChangeRequiresNewRevision(oldSet, newSet); got != tc.expected {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+		})
+	}
+
+	set := &StatefulSet{}
+	if ChangeRequiresNewRevision(nil, nil) {
+		t.Errorf("expected no new revision for both nil")
+	}
+	if !ChangeRequiresNewRevision(nil, set) || !ChangeRequiresNewRevision(set, nil) {
+		t.Errorf("expected new revision if only one of them is nil")
+	}
+}
+
+func TestClampMinReadySeconds(t *testing.T) {
+	cases := []struct {
+		name     string
+		value    *int32
+		expected *int32
+	}{
+		{name: "nil"},
+		{name: "above maximum", value: int32Ptr(350), expected: int32Ptr(MaxMinReadySeconds)},
+		{name: "negative", value: int32Ptr(-1), expected: int32Ptr(0)},
+		{name: "in range", value: int32Ptr(30), expected: int32Ptr(30)},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := ClampMinReadySeconds(tc.value)
+			if (got == nil) != (tc.expected == nil) || (got != nil && *got != *tc.expected) {
+				t.Fatalf("expected %v, got %v", tc.expected, got)
+			}
+			if got != nil && got == tc.value {
+				t.Fatalf("expected a new pointer")
+			}
+		})
+	}
+}
+
+func TestPartitionSemantics(t *testing.T) {
+	cases := []struct {
+		name          string
+		rollingUpdate *RollingUpdateStatefulSetStrategy
+		expected      string
+	}{
+		{name: "nil strategy", expected: PartitionSemanticsOrdinalThreshold},
+		{
+			name:          "ordered update",
+			rollingUpdate: &RollingUpdateStatefulSetStrategy{Partition: int32Ptr(2)},
+			expected:      PartitionSemanticsOrdinalThreshold,
+		},
+		{
+			name:          "unordered update",
+			rollingUpdate: &RollingUpdateStatefulSetStrategy{Partition: int32Ptr(2), UnorderedUpdate: &UnorderedUpdateStrategy{}},
+			expected:      PartitionSemanticsNonUpdatedCount,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &StatefulSetSpec{UpdateStrategy: StatefulSetUpdateStrategy{RollingUpdate: tc.rollingUpdate}}
+			if got := spec.PartitionSemantics(); got != tc.expected {
+				t.Fatalf("expected %q, got %q", tc.expected, got)
+			}
+		})
+	}
+}
+
+func TestStatefulSetFromDeployment(t *testing.T) {
+	maxUnavailable := intstr.FromString("25%")
+	d := &apps.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Namespace: "default", Name: "foo", Labels: map[string]string{"app": "foo"}},
+		Spec: apps.DeploymentSpec{
+			Replicas:             int32Ptr(3),
+			Selector:             &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+			Template:             v1.PodTemplateSpec{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "main", Image: "nginx:1.19"}}}},
+			RevisionHistoryLimit: int32Ptr(5),
+			MinReadySeconds:      600,
+			Strategy: apps.DeploymentStrategy{
+				Type:          apps.RollingUpdateDeploymentStrategyType,
+				RollingUpdate: &apps.RollingUpdateDeployment{MaxUnavailable: &maxUnavailable},
+			},
+		},
+	}
+
+	set := StatefulSetFromDeployment(d, "foo-headless")
+	if set.Namespace != d.Namespace || set.Name != d.Name || !apiequality.Semantic.DeepEqual(set.Labels, d.Labels) {
+		t.Fatalf("expected metadata mapped from deployment, got %v", set.ObjectMeta)
+	}
+	if set.Spec.PodManagementPolicy != apps.ParallelPodManagement {
+		t.Fatalf("expected Parallel podManagementPolicy, got %s", set.Spec.PodManagementPolicy)
+	}
+	if set.Spec.ServiceName != "foo-headless" {
+		t.Fatalf("expected serviceName foo-headless, got %s", set.Spec.ServiceName)
+	}
+	if *set.Spec.Replicas != 3 || *set.Spec.RevisionHistoryLimit != 5 {
+		t.Fatalf("expected replicas and revisionHistoryLimit mapped, got %v and %v", *set.Spec.Replicas, *set.Spec.RevisionHistoryLimit)
+	}
+	if !apiequality.Semantic.DeepEqual(set.Spec.Selector, d.Spec.Selector) || !apiequality.Semantic.DeepEqual(set.Spec.Template, d.Spec.Template) {
+		t.Fatalf("expected selector and template mapped from deployment")
+	}
+	if len(set.Spec.VolumeClaimTemplates) != 0 {
+		t.Fatalf("expected no volumeClaimTemplates, got %v", set.Spec.VolumeClaimTemplates)
+	}
+
+	rollingUpdate := set.Spec.UpdateStrategy.RollingUpdate
+	if set.Spec.UpdateStrategy.Type != apps.RollingUpdateStatefulSetStrategyType || rollingUpdate == nil {
+		t.Fatalf("expected RollingUpdate strategy, got %v", set.Spec.UpdateStrategy)
+	}
+	if rollingUpdate.MaxUnavailable == nil || *rollingUpdate.MaxUnavailable != maxUnavailable {
+		t.Fatalf("expected maxUnavailable %s, got %v", maxUnavailable.String(), rollingUpdate.MaxUnavailable)
+	}
+	if rollingUpdate.MinReadySeconds == nil || *rollingUpdate.MinReadySeconds != MaxMinReadySeconds {
+		t.Fatalf("expected minReadySeconds clamped to %d, got %v", MaxMinReadySeconds, rollingUpdate.MinReadySeconds)
+	}
+
+	set.Spec.Template.Spec.Containers[0].Image = "nginx:1.20"
+	if d.Spec.Template.Spec.Containers[0].Image != "nginx:1.19" {
+		t.Fatalf("expected the deployment not to be modified")
+	}
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
+
+func intOrStrPtr(v intstr.IntOrString) *intstr.IntOrString {
+	return &v
+}
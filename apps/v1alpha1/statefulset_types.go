@@ -100,11 +100,13 @@ const (
 	// during Pod update, which is the default behavior
 	RecreatePodUpdateStrategyType PodUpdateStrategyType = "ReCreate"
 	// InPlaceIfPossiblePodUpdateStrategyType indicates that we try to in-place update Pod instead of
-	// recreating Pod when possible. Currently, only image update of pod spec is allowed. Any other changes to the pod
+	// recreating Pod when possible. Currently, only image update of pod spec is allowed, and resources update is also
+	// allowed if the Resources feature is enabled in inPlaceUpdateStrategy. Any other changes to the pod
 	// spec will fall back to ReCreate PodUpdateStrategyType where pod will be recreated.
 	InPlaceIfPossiblePodUpdateStrategyType PodUpdateStrategyType = "InPlaceIfPossible"
 	// InPlaceOnlyPodUpdateStrategyType indicates that we will in-place update Pod instead of
-	// recreating pod. Currently we only allow image update for pod spec. Any other changes to the pod spec will be
+	// recreating pod. Currently we only allow image update for pod spec, and resources update if the Resources
+	// feature is enabled in inPlaceUpdateStrategy. Any other changes to the pod spec will be
 	// rejected by kube-apiserver
 	InPlaceOnlyPodUpdateStrategyType PodUpdateStrategyType = "InPlaceOnly"
 )
@@ -195,6 +197,16 @@ type StatefulSetStatus struct {
 	// indicated by updateRevision.
 	UpdatedReplicas int32 `json:"updatedReplicas"`
 
+	// updatedReadyReplicas is the number of Pods created by the StatefulSet controller from the StatefulSet version
+	// indicated by updateRevision and have a Ready Condition.
+	// +optional
+	UpdatedReadyReplicas int32 `json:"updatedReadyReplicas,omitempty"`
+
+	// updatedAvailableReplicas is the number of Pods created by the StatefulSet controller from the StatefulSet version
+	// indicated by updateRevision and have been ready for minReadySeconds.
+	// +optional
+	UpdatedAvailableReplicas int32 `json:"updatedAvailableReplicas,omitempty"`
+
 	// currentRevision, if not empty, indicates the version of the StatefulSet used to generate Pods in the
 	// sequence [0,currentReplicas).
 	CurrentRevision string `json:"currentRevision,omitempty"`
@@ -0,0 +1,190 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"fmt"
+
+	appspub "github.com/openkruise/kruise-api/apps/pub"
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// defaultRevisionHistoryLimit is the default value of RevisionHistoryLimit.
+const defaultRevisionHistoryLimit int32 = 10
+
+// WarningsForStatefulSetSpec returns the warnings of the spec, fldPath should be the path of spec.
+// Warnings are advisory, they do not make the object invalid and should be surfaced to users,
+// e.g. as admission warnings, instead of rejecting the object. It reports:
+//   - rollingUpdate.minReadySeconds that has no effect without readinessGates or readiness probes,
+//   - rollingUpdate.maxUnavailable that resolves to 0 and stalls the pods to be recreated,
+//   - volumeClaimTemplates requesting ReadWriteMany, while the claims are created per ordinal.
+func WarningsForStatefulSetSpec(spec *StatefulSetSpec, fldPath *field.Path) []string {
+	var warnings []string
+	if spec == nil {
+		return warnings
+	}
+
+	warnings = append(warnings, minReadySecondsWarnings(spec, fldPath)...)
+	warnings = append(warnings, maxUnavailableWarnings(spec, fldPath)...)
+	warnings = append(warnings, pvcAccessModesWarnings(spec, fldPath)...)
+	return warnings
+}
+
+func minReadySecondsWarnings(spec *StatefulSetSpec, fldPath *field.Path) []string {
+	if spec.UpdateStrategy.RollingUpdate == nil {
+		return nil
+	}
+
+	minReadySeconds := spec.UpdateStrategy.RollingUpdate.MinReadySeconds
+	if minReadySeconds == nil || *minReadySeconds <= 0 {
+		return nil
+	}
+	if len(spec.Template.Spec.ReadinessGates) > 0 {
+		return nil
+	}
+	for _, c := range spec.Template.Spec.Containers {
+		if c.ReadinessProbe != nil {
+			return nil
+		}
+	}
+
+	return []string{fmt.Sprintf("%s: has no effect since pod template has neither readinessGates nor container readinessProbe",
+		fldPath.Child("updateStrategy", "rollingUpdate", "minReadySeconds"))}
+}
+
+// ValidateSelector checks the selector is specified and not empty,
+// since an empty selector matches all pods.
+func ValidateSelector(spec *StatefulSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.Selector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("selector"), ""))
+		return allErrs
+	}
+	if len(spec.Selector.MatchLabels)+len(spec.Selector.MatchExpressions) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("selector"), spec.Selector, "empty selector is invalid for statefulset"))
+	}
+	return allErrs
+}
+
+// ValidateRevisionHistoryLimitChange rejects decreasing RevisionHistoryLimit below the number of
+// revisions still in use, which can not be garbage collected. Since ControllerRevisions are invisible
+// to the types package, the activeRevisions should be provided by callers such as webhooks.
+// fldPath should be the path of spec.
+func ValidateRevisionHistoryLimitChange(oldSet, newSet *StatefulSet, activeRevisions int, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	oldLimit, newLimit := defaultRevisionHistoryLimit, defaultRevisionHistoryLimit
+	if oldSet.Spec.RevisionHistoryLimit != nil {
+		oldLimit = *oldSet.Spec.RevisionHistoryLimit
+	}
+	if newSet.Spec.RevisionHistoryLimit != nil {
+		newLimit = *newSet.Spec.RevisionHistoryLimit
+	}
+
+	if newLimit < oldLimit && int(newLimit) < activeRevisions {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("revisionHistoryLimit"), newLimit,
+			fmt.Sprintf("must not be decreased below the number of revisions in use (%d)", activeRevisions)))
+	}
+	return allErrs
+}
+
+func maxUnavailableWarnings(spec *StatefulSetSpec, fldPath *field.Path) []string {
+	rollingUpdate := spec.UpdateStrategy.RollingUpdate
+	if rollingUpdate == nil || rollingUpdate.PodUpdatePolicy == InPlaceOnlyPodUpdateStrategyType {
+		return nil
+	}
+
+	replicas := int32(1)
+	if spec.Replicas != nil {
+		replicas = *spec.Replicas
+	}
+	if !rollingUpdate.BlocksRecreateUpdates(replicas) {
+		return nil
+	}
+	return []string{fmt.Sprintf("%s: %s resolves to 0, pods that need to be recreated will never be updated",
+		fldPath.Child("updateStrategy", "rollingUpdate", "maxUnavailable"), rollingUpdate.MaxUnavailable.String())}
+}
+
+func pvcAccessModesWarnings(spec *StatefulSetSpec, fldPath *field.Path) []string {
+	var warnings []string
+	for i, claim := range spec.VolumeClaimTemplates {
+		for j, mode := range claim.Spec.AccessModes {
+			if mode == v1.ReadWriteMany {
+				modePath := fldPath.Child("volumeClaimTemplates").Index(i).Child("spec", "accessModes").Index(j)
+				warnings = append(warnings, fmt.Sprintf("%s: %s is requested, but claims are created per ordinal and %s is usually expected",
+					modePath, mode, v1.ReadWriteOnce))
+			}
+		}
+	}
+	return warnings
+}
+
+// ValidateInPlaceContainerRefs checks the container names referenced by InPlaceUpdateStrategy
+// exist in the containers of pod template.
+func ValidateInPlaceContainerRefs(spec *StatefulSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.UpdateStrategy.RollingUpdate == nil {
+		return allErrs
+	}
+
+	containerNames := make(map[string]struct{}, len(spec.Template.Spec.Containers))
+	for _, c := range spec.Template.Spec.Containers {
+		containerNames[c.Name] = struct{}{}
+	}
+
+	strategyPath := fldPath.Child("updateStrategy", "rollingUpdate", "inPlaceUpdateStrategy")
+	for _, ref := range inPlaceContainerRefs(spec.UpdateStrategy.RollingUpdate.InPlaceUpdateStrategy, strategyPath) {
+		if _, ok := containerNames[ref.name]; !ok {
+			allErrs = append(allErrs, field.NotFound(ref.path, ref.name))
+		}
+	}
+	return allErrs
+}
+
+type containerRef struct {
+	path *field.Path
+	name string
+}
+
+// inPlaceContainerRefs returns the container names referenced by InPlaceUpdateStrategy.
+// InPlaceUpdateStrategy has no container reference for now, new fields referring to
+// containers should be collected here.
+func inPlaceContainerRefs(strategy *appspub.InPlaceUpdateStrategy, fldPath *field.Path) []containerRef {
+	return nil
+}
+
+// ValidateParallelRequirements checks the features which can only work with Parallel podManagementPolicy,
+// and returns one error for each of them used with other policies.
+func ValidateParallelRequirements(spec *StatefulSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.PodManagementPolicy == apps.ParallelPodManagement || spec.UpdateStrategy.RollingUpdate == nil {
+		return allErrs
+	}
+
+	rollingUpdate := spec.UpdateStrategy.RollingUpdate
+	rollingUpdatePath := fldPath.Child("updateStrategy", "rollingUpdate")
+	if rollingUpdate.MaxUnavailable != nil {
+		allErrs = append(allErrs, field.Forbidden(rollingUpdatePath.Child("maxUnavailable"),
+			"maxUnavailable can just work with Parallel podManagementPolicy"))
+	}
+	if rollingUpdate.UnorderedUpdate != nil {
+		allErrs = append(allErrs, field.Forbidden(rollingUpdatePath.Child("unorderedUpdate"),
+			"unorderedUpdate can just work with Parallel podManagementPolicy"))
+	}
+	return allErrs
+}
@@ -0,0 +1,304 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	"testing"
+
+	appspub "github.com/openkruise/kruise-api/apps/pub"
+	apps "k8s.io/api/apps/v1"
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestWarningsForStatefulSetSpecMinReadySeconds(t *testing.T) {
+	minReadySeconds := int32(10)
+	probe := &v1.Probe{Handler: v1.Handler{TCPSocket: &v1.TCPSocketAction{Port: intstr.FromInt(80)}}}
+	cases := []struct {
+		name            string
+		minReadySeconds *int32
+		readinessProbe  *v1.Probe
+		readinessGates  []v1.PodReadinessGate
+		expectWarning   bool
+	}{
+		{name: "minReadySeconds unset"},
+		{name: "probe-less template with minReadySeconds", minReadySeconds: &minReadySeconds, expectWarning: true},
+		{name: "template with readiness probe", minReadySeconds: &minReadySeconds, readinessProbe: probe},
+		{
+			name:            "template with readiness gates",
+			minReadySeconds: &minReadySeconds,
+			readinessGates:  []v1.PodReadinessGate{{ConditionType: "example.com/ready"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &StatefulSetSpec{
+				Template: v1.PodTemplateSpec{Spec: v1.PodSpec{
+					Containers:     []v1.Container{{Name: "main", ReadinessProbe: tc.readinessProbe}},
+					ReadinessGates: tc.readinessGates,
+				}},
+				UpdateStrategy: StatefulSetUpdateStrategy{
+					RollingUpdate: &RollingUpdateStatefulSetStrategy{MinReadySeconds: tc.minReadySeconds},
+				},
+			}
+			warnings := WarningsForStatefulSetSpec(spec, field.NewPath("spec"))
+			if tc.expectWarning != (len(warnings) > 0) {
+				t.Fatalf("expected warning %v, got %v", tc.expectWarning, warnings)
+			}
+			expected := "spec.updateStrategy.rollingUpdate.minReadySeconds: has no effect since pod template has neither readinessGates nor container readinessProbe"
+			for _, w := range warnings {
+				if w != expected {
+					t.Errorf("expected warning %q, got %q", expected, w)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateSelector(t *testing.T) {
+	cases := []struct {
+		name         string
+		selector     *metav1.LabelSelector
+		expectedType field.ErrorType
+	}{
+		{name: "nil selector", expectedType: field.ErrorTypeRequired},
+		{name: "empty selector", selector: &metav1.LabelSelector{}, expectedType: field.ErrorTypeInvalid},
+		{name: "selector with matchLabels", selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}}},
+		{
+			name: "selector with matchExpressions",
+			selector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+				{Key: "app", Operator: metav1.LabelSelectorOpExists},
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateSelector(&StatefulSetSpec{Selector: tc.selector}, field.NewPath("spec"))
+			if tc.expectedType == "" {
+				if len(errs) != 0 {
+					t.Fatalf("expected no error, got %v", errs)
+				}
+				return
+			}
+			if len(errs) != 1 || errs[0].Type != tc.expectedType || errs[0].Field != "spec.selector" {
+				t.Fatalf("expected %s error on spec.selector, got %v", tc.expectedType, errs)
+			}
+		})
+	}
+}
+
+func TestValidateRevisionHistoryLimitChange(t *testing.T) {
+	cases := []struct {
+		name            string
+		oldLimit        *int32
+		newLimit        *int32
+		activeRevisions int
+		expectError     bool
+	}{
+		{name: "safe decrease", oldLimit: int32Ptr(10), newLimit: int32Ptr(5), activeRevisions: 3},
+		{name: "unsafe decrease", oldLimit: int32Ptr(10), newLimit: int32Ptr(2), activeRevisions: 3, expectError: true},
+		{name: "unsafe decrease from default", newLimit: int32Ptr(2), activeRevisions: 3, expectError: true},
+		{name: "increase", oldLimit: int32Ptr(2), newLimit: int32Ptr(5), activeRevisions: 8},
+		{name: "unchanged", oldLimit: int32Ptr(2), newLimit: int32Ptr(2), activeRevisions: 8},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			oldSet := &StatefulSet{Spec: StatefulSetSpec{RevisionHistoryLimit: tc.oldLimit}}
+			newSet := &StatefulSet{Spec: StatefulSetSpec{RevisionHistoryLimit: tc.newLimit}}
+			errs := ValidateRevisionHistoryLimitChange(oldSet, newSet, tc.activeRevisions, field.NewPath("spec"))
+			if tc.expectError != (len(errs) > 0) {
+				t.Fatalf("expected error %v, got %v", tc.expectError, errs)
+			}
+			for _, err := range errs {
+				if err.Field != "spec.revisionHistoryLimit" {
+					t.Errorf("expected error on spec.revisionHistoryLimit, got %s", err.Field)
+				}
+			}
+		})
+	}
+}
+
+func TestWarningsForStatefulSetSpecMaxUnavailable(t *testing.T) {
+	cases := []struct {
+		name            string
+		maxUnavailable  intstr.IntOrString
+		podUpdatePolicy PodUpdateStrategyType
+		expectedWarning string
+	}{
+		{
+			name:            "0",
+			maxUnavailable:  intstr.FromInt(0),
+			expectedWarning: "spec.updateStrategy.rollingUpdate.maxUnavailable: 0 resolves to 0, pods that need to be recreated will never be updated",
+		},
+		{
+			name:            "0%",
+			maxUnavailable:  intstr.FromString("0%"),
+			expectedWarning: "spec.updateStrategy.rollingUpdate.maxUnavailable: 0% resolves to 0, pods that need to be recreated will never be updated",
+		},
+		{name: "normal value", maxUnavailable: intstr.FromInt(1)},
+		{name: "0 with InPlaceOnly", maxUnavailable: intstr.FromInt(0), podUpdatePolicy: InPlaceOnlyPodUpdateStrategyType},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &StatefulSetSpec{
+				Replicas: int32Ptr(5),
+				UpdateStrategy: StatefulSetUpdateStrategy{
+					RollingUpdate: &RollingUpdateStatefulSetStrategy{
+						MaxUnavailable:  &tc.maxUnavailable,
+						PodUpdatePolicy: tc.podUpdatePolicy,
+					},
+				},
+			}
+			warnings := WarningsForStatefulSetSpec(spec, field.NewPath("spec"))
+			if tc.expectedWarning == "" {
+				if len(warnings) != 0 {
+					t.Fatalf("expected no warning, got %v", warnings)
+				}
+				return
+			}
+			if len(warnings) != 1 || warnings[0] != tc.expectedWarning {
+				t.Fatalf("expected warning %q, got %v", tc.expectedWarning, warnings)
+			}
+		})
+	}
+}
+
+func TestWarningsForStatefulSetSpecPVCAccessModes(t *testing.T) {
+	cases := []struct {
+		name             string
+		accessModes      []v1.PersistentVolumeAccessMode
+		expectedWarnings []string
+	}{
+		{name: "ReadWriteOnce", accessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce}},
+		{
+			name:        "ReadWriteMany",
+			accessModes: []v1.PersistentVolumeAccessMode{v1.ReadWriteOnce, v1.ReadWriteMany},
+			expectedWarnings: []string{"spec.volumeClaimTemplates[0].spec.accessModes[1]: ReadWriteMany is requested, " +
+				"but claims are created per ordinal and ReadWriteOnce is usually expected"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &StatefulSetSpec{VolumeClaimTemplates: []v1.PersistentVolumeClaim{
+				{Spec: v1.PersistentVolumeClaimSpec{AccessModes: tc.accessModes}},
+			}}
+			warnings := WarningsForStatefulSetSpec(spec, field.NewPath("spec"))
+			if len(warnings) != len(tc.expectedWarnings) {
+				t.Fatalf("expected %d warnings, got %v", len(tc.expectedWarnings), warnings)
+			}
+			for i, w := range warnings {
+				if w != tc.expectedWarnings[i] {
+					t.Errorf("expected warning %q, got %q", tc.expectedWarnings[i], w)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateInPlaceContainerRefs(t *testing.T) {
+	// InPlaceUpdateStrategy has no container reference for now, so no error is expected in any case.
+	// Cases for dangling references should be added along with the new fields.
+	cases := []struct {
+		name          string
+		rollingUpdate *RollingUpdateStatefulSetStrategy
+	}{
+		{name: "no rolling update"},
+		{name: "no in-place update strategy", rollingUpdate: &RollingUpdateStatefulSetStrategy{}},
+		{
+			name: "in-place update strategy",
+			rollingUpdate: &RollingUpdateStatefulSetStrategy{
+				InPlaceUpdateStrategy: &appspub.InPlaceUpdateStrategy{GracePeriodSeconds: 10},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &StatefulSetSpec{
+				Template:       v1.PodTemplateSpec{Spec: v1.PodSpec{Containers: []v1.Container{{Name: "main"}}}},
+				UpdateStrategy: StatefulSetUpdateStrategy{RollingUpdate: tc.rollingUpdate},
+			}
+			if errs := ValidateInPlaceContainerRefs(spec, field.NewPath("spec")); len(errs) != 0 {
+				t.Fatalf("expected no error, got %v", errs)
+			}
+		})
+	}
+}
+
+func TestValidateParallelRequirements(t *testing.T) {
+	maxUnavailable := intstr.FromInt(2)
+	cases := []struct {
+		name           string
+		policy         apps.PodManagementPolicyType
+		rollingUpdate  *RollingUpdateStatefulSetStrategy
+		expectedFields []string
+	}{
+		{
+			name:   "no rolling update",
+			policy: apps.OrderedReadyPodManagement,
+		},
+		{
+			name:          "maxUnavailable with OrderedReady",
+			policy:        apps.OrderedReadyPodManagement,
+			rollingUpdate: &RollingUpdateStatefulSetStrategy{MaxUnavailable: &maxUnavailable},
+			expectedFields: []string{
+				"spec.updateStrategy.rollingUpdate.maxUnavailable",
+			},
+		},
+		{
+			name:          "unorderedUpdate with OrderedReady",
+			policy:        apps.OrderedReadyPodManagement,
+			rollingUpdate: &RollingUpdateStatefulSetStrategy{UnorderedUpdate: &UnorderedUpdateStrategy{}},
+			expectedFields: []string{
+				"spec.updateStrategy.rollingUpdate.unorderedUpdate",
+			},
+		},
+		{
+			name:   "both features with Parallel",
+			policy: apps.ParallelPodManagement,
+			rollingUpdate: &RollingUpdateStatefulSetStrategy{
+				MaxUnavailable:  &maxUnavailable,
+				UnorderedUpdate: &UnorderedUpdateStrategy{},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &StatefulSetSpec{
+				PodManagementPolicy: tc.policy,
+				UpdateStrategy:      StatefulSetUpdateStrategy{RollingUpdate: tc.rollingUpdate},
+			}
+			errs := ValidateParallelRequirements(spec, field.NewPath("spec"))
+			if len(errs) != len(tc.expectedFields) {
+				t.Fatalf("expected %d errors, got %v", len(tc.expectedFields), errs)
+			}
+			for i, err := range errs {
+				if err.Type != field.ErrorTypeForbidden || err.Field != tc.expectedFields[i] {
+					t.Errorf("expected forbidden error on %s, got %v", tc.expectedFields[i], err)
+				}
+			}
+		})
+	}
+}
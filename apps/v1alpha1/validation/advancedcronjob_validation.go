@@ -0,0 +1,181 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	appsv1alpha1 "github.com/openkruise/kruise-api/apps/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateAdvancedCronJobSpec validates the spec of AdvancedCronJob, fldPath should be the path of spec.
+// The templates should be validated by the caller with the validation of their own types, and suspendUntil
+// should be checked with ValidateAdvancedCronJobSuspendUntil on create.
+func ValidateAdvancedCronJobSpec(spec *appsv1alpha1.AdvancedCronJobSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if len(spec.Schedule) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("schedule"), ""))
+	} else if err := validateCronSchedule(spec.Schedule); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("schedule"), spec.Schedule, err.Error()))
+	}
+	if spec.StartingDeadlineSeconds != nil && *spec.StartingDeadlineSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("startingDeadlineSeconds"), *spec.StartingDeadlineSeconds, "must be greater than or equal to 0"))
+	}
+	switch spec.ConcurrencyPolicy {
+	case "", appsv1alpha1.AllowConcurrent, appsv1alpha1.ForbidConcurrent, appsv1alpha1.ReplaceConcurrent:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("concurrencyPolicy"), spec.ConcurrencyPolicy,
+			[]string{string(appsv1alpha1.AllowConcurrent), string(appsv1alpha1.ForbidConcurrent), string(appsv1alpha1.ReplaceConcurrent)}))
+	}
+	if spec.SuccessfulJobsHistoryLimit != nil && *spec.SuccessfulJobsHistoryLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("successfulJobsHistoryLimit"), *spec.SuccessfulJobsHistoryLimit, "must be greater than or equal to 0"))
+	}
+	if spec.FailedJobsHistoryLimit != nil && *spec.FailedJobsHistoryLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("failedJobsHistoryLimit"), *spec.FailedJobsHistoryLimit, "must be greater than or equal to 0"))
+	}
+
+	allErrs = append(allErrs, appsv1alpha1.ValidateCronJobTemplate(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1alpha1.ValidateAdvancedCronJobTimeZone(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1alpha1.ValidateAdvancedCronJobMissedRunPolicy(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1alpha1.ValidateAdvancedCronJobNotification(spec, fldPath)...)
+	return allErrs
+}
+
+// cronDescriptors are the predefined schedules that can be used instead of the five fields.
+var cronDescriptors = sets.NewString("@yearly", "@annually", "@monthly", "@weekly", "@daily", "@midnight", "@hourly")
+
+// cronField describes the bounds of a field in the standard cron expression.
+type cronField struct {
+	name              string
+	min, max          int
+	names             map[string]int
+	allowQuestionMark bool
+}
+
+var cronFields = []cronField{
+	{name: "minute", min: 0, max: 59},
+	{name: "hour", min: 0, max: 23},
+	{name: "day of month", min: 1, max: 31, allowQuestionMark: true},
+	{name: "month", min: 1, max: 12, names: map[string]int{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}},
+	{name: "day of week", min: 0, max: 6, allowQuestionMark: true, names: map[string]int{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}},
+}
+
+// validateCronSchedule checks the schedule is either a standard cron expression with five fields,
+// a predefined descriptor such as @daily, or @every followed by a duration, optionally prefixed
+// with TZ= or CRON_TZ=. It follows the syntax accepted by the Kruise controller.
+func validateCronSchedule(schedule string) error {
+	schedule = strings.TrimSpace(schedule)
+	if strings.HasPrefix(schedule, "TZ=") || strings.HasPrefix(schedule, "CRON_TZ=") {
+		i := strings.Index(schedule, " ")
+		if i == -1 {
+			return fmt.Errorf("missing schedule after time zone")
+		}
+		schedule = strings.TrimSpace(schedule[i:])
+	}
+
+	if strings.HasPrefix(schedule, "@") {
+		if strings.HasPrefix(schedule, "@every ") {
+			duration, err := time.ParseDuration(strings.TrimSpace(strings.TrimPrefix(schedule, "@every ")))
+			if err != nil {
+				return fmt.Errorf("failed to parse duration of @every: %v", err)
+			}
+			if duration < time.Second {
+				return fmt.Errorf("duration of @every must be at least 1s")
+			}
+			return nil
+		}
+		if !cronDescriptors.Has(schedule) {
+			return fmt.Errorf("unrecognized descriptor: %s", schedule)
+		}
+		return nil
+	}
+
+	fields := strings.Fields(schedule)
+	if len(fields) != len(cronFields) {
+		return fmt.Errorf("expected exactly %d fields, found %d", len(cronFields), len(fields))
+	}
+	for i := range cronFields {
+		if err := cronFields[i].validate(fields[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// validate checks a field that is a comma-separated list of '*', '?', values and ranges, each with an optional step.
+func (f *cronField) validate(expr string) error {
+	for _, item := range strings.Split(expr, ",") {
+		rangeAndStep := strings.Split(item, "/")
+		if len(rangeAndStep) > 2 {
+			return fmt.Errorf("too many slashes in %s: %s", f.name, item)
+		}
+
+		start, end := f.min, f.max
+		if rangeExpr := rangeAndStep[0]; rangeExpr != "*" && !(rangeExpr == "?" && f.allowQuestionMark) {
+			lowAndHigh := strings.Split(rangeExpr, "-")
+			if len(lowAndHigh) > 2 {
+				return fmt.Errorf("too many hyphens in %s: %s", f.name, item)
+			}
+			var err error
+			if start, err = f.parseValue(lowAndHigh[0]); err != nil {
+				return err
+			}
+			if len(lowAndHigh) == 2 {
+				if end, err = f.parseValue(lowAndHigh[1]); err != nil {
+					return err
+				}
+			} else if len(rangeAndStep) == 1 {
+				end = start
+			}
+		}
+		if start > end {
+			return fmt.Errorf("beginning of range %d beyond end of range %d in %s: %s", start, end, f.name, item)
+		}
+
+		if len(rangeAndStep) == 2 {
+			if step, err := strconv.Atoi(rangeAndStep[1]); err != nil || step <= 0 {
+				return fmt.Errorf("step must be a positive integer in %s: %s", f.name, item)
+			}
+		}
+	}
+	return nil
+}
+
+func (f *cronField) parseValue(value string) (int, error) {
+	if v, ok := f.names[strings.ToLower(value)]; ok {
+		return v, nil
+	}
+	v, err := strconv.Atoi(value)
+	if err != nil {
+		return 0, fmt.Errorf("failed to parse %s: %q", f.name, value)
+	}
+	if v < f.min || v > f.max {
+		return 0, fmt.Errorf("%s %d out of range [%d, %d]", f.name, v, f.min, f.max)
+	}
+	return v, nil
+}
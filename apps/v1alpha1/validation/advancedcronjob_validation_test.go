@@ -0,0 +1,45 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	appsv1alpha1 "github.com/openkruise/kruise-api/apps/v1alpha1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateAdvancedCronJobSpecFieldPath(t *testing.T) {
+	spec := &appsv1alpha1.AdvancedCronJobSpec{
+		Schedule:        "*/5 * * * *",
+		MissedRunPolicy: "Unknown",
+	}
+
+	errs := ValidateAdvancedCronJobSpec(spec, field.NewPath("items").Index(0).Child("spec"))
+	expected := []string{
+		"items[0].spec.template",
+		"items[0].spec.missedRunPolicy",
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %v", len(expected), errs)
+	}
+	for i, err := range errs {
+		if err.Field != expected[i] {
+			t.Errorf("expected error on %s, got %s", expected[i], err.Field)
+		}
+	}
+}
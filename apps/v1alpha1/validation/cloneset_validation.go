@@ -0,0 +1,89 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	appsv1alpha1 "github.com/openkruise/kruise-api/apps/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateCloneSetSpec validates the spec of CloneSet, fldPath should be the path of spec.
+// The pod template should be validated by the caller with the upstream validation of core types.
+func ValidateCloneSetSpec(spec *appsv1alpha1.CloneSetSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.Replicas != nil && *spec.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replicas"), *spec.Replicas, "must be greater than or equal to 0"))
+	}
+	if spec.RevisionHistoryLimit != nil && *spec.RevisionHistoryLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("revisionHistoryLimit"), *spec.RevisionHistoryLimit, "must be greater than or equal to 0"))
+	}
+	if spec.MinReadySeconds < 0 || spec.MinReadySeconds > appsv1alpha1.MaxMinReadySeconds {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReadySeconds"), spec.MinReadySeconds,
+			fmt.Sprintf("must be in the range of [0, %d]", appsv1alpha1.MaxMinReadySeconds)))
+	}
+
+	allErrs = append(allErrs, validateCloneSetScaleStrategy(&spec.ScaleStrategy, fldPath.Child("scaleStrategy"))...)
+	allErrs = append(allErrs, validateCloneSetUpdateStrategy(&spec.UpdateStrategy, fldPath.Child("updateStrategy"))...)
+
+	switch spec.VolumeClaimUpdateStrategy.Type {
+	case "", appsv1alpha1.RecreateCloneSetVolumeClaimUpdateStrategyType, appsv1alpha1.InPlaceExpandCloneSetVolumeClaimUpdateStrategyType:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("volumeClaimUpdateStrategy", "type"), spec.VolumeClaimUpdateStrategy.Type,
+			[]string{string(appsv1alpha1.RecreateCloneSetVolumeClaimUpdateStrategyType), string(appsv1alpha1.InPlaceExpandCloneSetVolumeClaimUpdateStrategyType)}))
+	}
+	return allErrs
+}
+
+func validateCloneSetScaleStrategy(strategy *appsv1alpha1.CloneSetScaleStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	// maxUnavailable only limits scaling out, so 0 will block scaling out forever.
+	if strategy.MaxUnavailable != nil {
+		if value, err := intstr.GetValueFromIntOrPercent(strategy.MaxUnavailable, 100, true); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), strategy.MaxUnavailable.String(), err.Error()))
+		} else if value <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), strategy.MaxUnavailable.String(), "must be greater than 0"))
+		}
+	}
+	return allErrs
+}
+
+func validateCloneSetUpdateStrategy(strategy *appsv1alpha1.CloneSetUpdateStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if strategy.PartitionSelector != nil {
+		if strategy.Partition != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("partitionSelector"), "can not be set together with partition"))
+		}
+		if _, err := metav1.LabelSelectorAsSelector(strategy.PartitionSelector); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("partitionSelector"), strategy.PartitionSelector, err.Error()))
+		}
+	}
+
+	switch strategy.RecreatePolicy {
+	case "", appsv1alpha1.DeleteCloneSetRecreatePolicyType, appsv1alpha1.EvictCloneSetRecreatePolicyType:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("recreatePolicy"), strategy.RecreatePolicy,
+			[]string{string(appsv1alpha1.DeleteCloneSetRecreatePolicyType), string(appsv1alpha1.EvictCloneSetRecreatePolicyType)}))
+	}
+	return allErrs
+}
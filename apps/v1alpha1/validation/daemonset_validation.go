@@ -0,0 +1,162 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	appsv1alpha1 "github.com/openkruise/kruise-api/apps/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateDaemonSetSpec validates the spec of Advanced DaemonSet, fldPath should be the path of spec.
+// The pod template should be validated by the caller with the upstream validation of core types.
+func ValidateDaemonSetSpec(spec *appsv1alpha1.DaemonSetSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.Selector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("selector"), ""))
+	} else if _, err := metav1.LabelSelectorAsSelector(spec.Selector); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("selector"), spec.Selector, err.Error()))
+	}
+	if spec.MinReadySeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("minReadySeconds"), spec.MinReadySeconds, "must be greater than or equal to 0"))
+	}
+	if spec.RevisionHistoryLimit != nil && *spec.RevisionHistoryLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("revisionHistoryLimit"), *spec.RevisionHistoryLimit, "must be greater than or equal to 0"))
+	}
+	if spec.BurstReplicas != nil {
+		if value, err := intstr.GetValueFromIntOrPercent(spec.BurstReplicas, 100, true); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("burstReplicas"), spec.BurstReplicas.String(), err.Error()))
+		} else if value <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("burstReplicas"), spec.BurstReplicas.String(), "must be greater than 0"))
+		}
+	}
+
+	allErrs = append(allErrs, validateDaemonSetUpdateStrategy(&spec.UpdateStrategy, fldPath.Child("updateStrategy"))...)
+
+	allErrs = append(allErrs, appsv1alpha1.ValidateDaemonSetRollingUpdateSelector(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1alpha1.ValidateDaemonSetLifecycle(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1alpha1.ValidateDaemonSetProgressive(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1alpha1.ValidateDaemonSetIgnoredTaints(spec, fldPath)...)
+	return allErrs
+}
+
+func validateDaemonSetUpdateStrategy(strategy *appsv1alpha1.DaemonSetUpdateStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch strategy.Type {
+	case "", appsv1alpha1.RollingUpdateDaemonSetStrategyType:
+		if strategy.RollingUpdate != nil {
+			allErrs = append(allErrs, validateRollingUpdateDaemonSet(strategy.RollingUpdate, fldPath.Child("rollingUpdate"))...)
+		}
+	case appsv1alpha1.OnDeleteDaemonSetStrategyType:
+		if strategy.RollingUpdate != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rollingUpdate"), strategy.RollingUpdate,
+				fmt.Sprintf("only allowed for updateStrategy '%s'", appsv1alpha1.RollingUpdateDaemonSetStrategyType)))
+		}
+		if strategy.InPlaceUpdateStrategy != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("inPlaceUpdateStrategy"), strategy.InPlaceUpdateStrategy,
+				fmt.Sprintf("only allowed for updateStrategy '%s'", appsv1alpha1.RollingUpdateDaemonSetStrategyType)))
+		}
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), strategy.Type,
+			[]string{string(appsv1alpha1.RollingUpdateDaemonSetStrategyType), string(appsv1alpha1.OnDeleteDaemonSetStrategyType)}))
+	}
+
+	if strategy.InPlaceUpdateStrategy != nil && strategy.InPlaceUpdateStrategy.GracePeriodSeconds < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("inPlaceUpdateStrategy", "gracePeriodSeconds"),
+			strategy.InPlaceUpdateStrategy.GracePeriodSeconds, "must be greater than or equal to 0"))
+	}
+	return allErrs
+}
+
+func validateRollingUpdateDaemonSet(rollingUpdate *appsv1alpha1.RollingUpdateDaemonSet, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch rollingUpdate.Type {
+	case "", appsv1alpha1.StandardRollingUpdateType, appsv1alpha1.SurgingRollingUpdateType, appsv1alpha1.InplaceRollingUpdateType:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("rollingUpdateType"), rollingUpdate.Type, []string{
+			string(appsv1alpha1.StandardRollingUpdateType), string(appsv1alpha1.SurgingRollingUpdateType), string(appsv1alpha1.InplaceRollingUpdateType)}))
+	}
+
+	maxUnavailable, errs := validatePositiveIntOrPercent(rollingUpdate.MaxUnavailable, fldPath.Child("maxUnavailable"))
+	allErrs = append(allErrs, errs...)
+	maxSurge, errs := validatePositiveIntOrPercent(rollingUpdate.MaxSurge, fldPath.Child("maxSurge"))
+	allErrs = append(allErrs, errs...)
+	if rollingUpdate.MaxSurge != nil && rollingUpdate.Type != appsv1alpha1.SurgingRollingUpdateType {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("maxSurge"),
+			fmt.Sprintf("only allowed for rollingUpdateType '%s'", appsv1alpha1.SurgingRollingUpdateType)))
+	}
+	// Explicitly specified maxUnavailable and maxSurge can be neither both non-zero nor both zero.
+	if maxUnavailable > 0 && maxSurge > 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxSurge"), rollingUpdate.MaxSurge.String(),
+			"may not be set when maxUnavailable is non-zero"))
+	}
+	if rollingUpdate.MaxUnavailable != nil && rollingUpdate.MaxSurge != nil && maxUnavailable == 0 && maxSurge == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), rollingUpdate.MaxUnavailable.String(),
+			"may not be 0 when maxSurge is 0"))
+	}
+
+	if rollingUpdate.Partition != nil && *rollingUpdate.Partition < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("partition"), *rollingUpdate.Partition, "must be greater than or equal to 0"))
+	}
+	if partitionPercent := rollingUpdate.PartitionPercent; partitionPercent != nil {
+		if rollingUpdate.Partition != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("partitionPercent"), "can not be set together with partition"))
+		}
+		if _, err := intstr.GetValueFromIntOrPercent(partitionPercent, 100, true); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("partitionPercent"), partitionPercent.String(), err.Error()))
+		} else if partitionPercent.Type == intstr.Int && partitionPercent.IntVal < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("partitionPercent"), partitionPercent.String(), "must be greater than or equal to 0"))
+		} else if partitionPercent.Type == intstr.String {
+			allErrs = append(allErrs, validatePercentRange(partitionPercent, fldPath.Child("partitionPercent"))...)
+		}
+	}
+	return allErrs
+}
+
+// validatePositiveIntOrPercent resolves the value against 100, and checks it is not negative and
+// not greater than 100%. It returns -1 if the value is not specified or invalid.
+func validatePositiveIntOrPercent(value *intstr.IntOrString, fldPath *field.Path) (int, field.ErrorList) {
+	allErrs := field.ErrorList{}
+	if value == nil {
+		return -1, allErrs
+	}
+	v, err := intstr.GetValueFromIntOrPercent(value, 100, true)
+	if err != nil {
+		return -1, append(allErrs, field.Invalid(fldPath, value.String(), err.Error()))
+	}
+	if v < 0 {
+		return -1, append(allErrs, field.Invalid(fldPath, value.String(), "must be greater than or equal to 0"))
+	}
+	if value.Type == intstr.String {
+		allErrs = append(allErrs, validatePercentRange(value, fldPath)...)
+	}
+	return v, allErrs
+}
+
+func validatePercentRange(value *intstr.IntOrString, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if v, err := intstr.GetValueFromIntOrPercent(value, 100, true); err == nil && (v < 0 || v > 100) {
+		allErrs = append(allErrs, field.Invalid(fldPath, value.String(), "must not be less than 0% or greater than 100%"))
+	}
+	return allErrs
+}
@@ -0,0 +1,100 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	appspub "github.com/openkruise/kruise-api/apps/pub"
+	appsv1alpha1 "github.com/openkruise/kruise-api/apps/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateDaemonSetSpecFieldPath(t *testing.T) {
+	spec := &appsv1alpha1.DaemonSetSpec{
+		Selector: &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+		Lifecycle: &appspub.Lifecycle{
+			PreNormal: &appspub.LifecycleHook{LabelsHandler: map[string]string{"ready": "true"}},
+		},
+	}
+
+	errs := ValidateDaemonSetSpec(spec, field.NewPath("items").Index(0).Child("spec"))
+	expected := []string{
+		"items[0].spec.lifecycle.preNormal",
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %v", len(expected), errs)
+	}
+	for i, err := range errs {
+		if err.Field != expected[i] {
+			t.Errorf("expected error on %s, got %s", expected[i], err.Field)
+		}
+	}
+}
+
+func TestValidateRollingUpdateDaemonSetPartition(t *testing.T) {
+	partition := int32(-1)
+	validPartition := int32(2)
+	percent := intstr.FromString("20%")
+	invalidPercent := intstr.FromString("120%")
+	cases := []struct {
+		name             string
+		partition        *int32
+		partitionPercent *intstr.IntOrString
+		expectErrors     []string
+	}{
+		{name: "partition", partition: &validPartition},
+		{name: "partitionPercent", partitionPercent: &percent},
+		{
+			name:         "negative partition",
+			partition:    &partition,
+			expectErrors: []string{"spec.updateStrategy.rollingUpdate.partition: Invalid value: -1: must be greater than or equal to 0"},
+		},
+		{
+			name:             "partition with partitionPercent",
+			partition:        &validPartition,
+			partitionPercent: &percent,
+			expectErrors:     []string{"spec.updateStrategy.rollingUpdate.partitionPercent: Forbidden: can not be set together with partition"},
+		},
+		{
+			name:             "partitionPercent over 100%",
+			partitionPercent: &invalidPercent,
+			expectErrors:     []string{"spec.updateStrategy.rollingUpdate.partitionPercent: Invalid value: \"120%\": must not be less than 0% or greater than 100%"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			rollingUpdate := &appsv1alpha1.RollingUpdateDaemonSet{
+				Type:             appsv1alpha1.StandardRollingUpdateType,
+				Partition:        tc.partition,
+				PartitionPercent: tc.partitionPercent,
+			}
+			errs := validateRollingUpdateDaemonSet(rollingUpdate, field.NewPath("spec", "updateStrategy", "rollingUpdate"))
+			if len(errs) != len(tc.expectErrors) {
+				t.Fatalf("expected %d errors, got %v", len(tc.expectErrors), errs)
+			}
+			for i, err := range errs {
+				if err.Error() != tc.expectErrors[i] {
+					t.Errorf("expected error %q, got %q", tc.expectErrors[i], err.Error())
+				}
+			}
+		})
+	}
+}
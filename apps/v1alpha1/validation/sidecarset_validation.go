@@ -0,0 +1,140 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	appsv1alpha1 "github.com/openkruise/kruise-api/apps/v1alpha1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateSidecarSetSpec validates the spec of SidecarSet, fldPath should be the path of spec.
+// The sidecar containers and volumes should be validated by the caller with the upstream validation of core types.
+func ValidateSidecarSetSpec(spec *appsv1alpha1.SidecarSetSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.Selector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("selector"), ""))
+	} else if _, err := metav1.LabelSelectorAsSelector(spec.Selector); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("selector"), spec.Selector, err.Error()))
+	}
+
+	allErrs = append(allErrs, validateSidecarContainerNames(spec, fldPath)...)
+	allErrs = append(allErrs, validateSidecarSetUpdateStrategy(&spec.UpdateStrategy, fldPath.Child("updateStrategy"))...)
+
+	allErrs = append(allErrs, appsv1alpha1.ValidateSidecarSetNamespace(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1alpha1.ValidateSidecarInjectionOrder(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1alpha1.ValidateSidecarHotUpgrade(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1alpha1.ValidateSidecarVolumePolicy(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1alpha1.ValidateSidecarMergePolicy(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1alpha1.ValidateSidecarTransferEnv(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1alpha1.ValidateSidecarInitContainerRestartPolicy(spec, fldPath)...)
+	return allErrs
+}
+
+// validateSidecarContainerNames checks the names of initContainers and containers are not empty and unique,
+// since they will be injected into the same pod.
+func validateSidecarContainerNames(spec *appsv1alpha1.SidecarSetSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.NewString()
+	check := func(containers []appsv1alpha1.SidecarContainer, containersPath *field.Path) {
+		for i := range containers {
+			name := containers[i].Name
+			if name == "" {
+				allErrs = append(allErrs, field.Required(containersPath.Index(i).Child("name"), ""))
+			} else if names.Has(name) {
+				allErrs = append(allErrs, field.Duplicate(containersPath.Index(i).Child("name"), name))
+			}
+			names.Insert(name)
+		}
+	}
+	check(spec.InitContainers, fldPath.Child("initContainers"))
+	check(spec.Containers, fldPath.Child("containers"))
+	return allErrs
+}
+
+func validateSidecarSetUpdateStrategy(strategy *appsv1alpha1.SidecarSetUpdateStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch strategy.Type {
+	case "", appsv1alpha1.NotUpdateSidecarSetStrategyType, appsv1alpha1.RollingUpdateSidecarSetStrategyType:
+	default:
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), strategy.Type,
+			[]string{string(appsv1alpha1.NotUpdateSidecarSetStrategyType), string(appsv1alpha1.RollingUpdateSidecarSetStrategyType)}))
+	}
+
+	if strategy.Selector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(strategy.Selector); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("selector"), strategy.Selector, err.Error()))
+		}
+	}
+	if strategy.Partition != nil {
+		if value, err := intstr.GetValueFromIntOrPercent(strategy.Partition, 100, true); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("partition"), strategy.Partition.String(), err.Error()))
+		} else if value < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("partition"), strategy.Partition.String(), "must be greater than or equal to 0"))
+		}
+	}
+	if strategy.MaxUnavailable != nil {
+		if value, err := intstr.GetValueFromIntOrPercent(strategy.MaxUnavailable, 100, true); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), strategy.MaxUnavailable.String(), err.Error()))
+		} else if value <= 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), strategy.MaxUnavailable.String(), "must be greater than 0"))
+		}
+	}
+	if err := strategy.ScatterStrategy.FieldsValidation(); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("scatterStrategy"), strategy.ScatterStrategy, err.Error()))
+	}
+	return allErrs
+}
+
+// ValidateSidecarSetUpdate validates the update of SidecarSet. Besides the validation of new spec,
+// the selector is immutable, and the upgradeType and hot upgrade empty image of existing containers can not be changed.
+func ValidateSidecarSetUpdate(newObj, oldObj *appsv1alpha1.SidecarSet) field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := ValidateSidecarSetSpec(&newObj.Spec, specPath)
+
+	if !apiequality.Semantic.DeepEqual(newObj.Spec.Selector, oldObj.Spec.Selector) {
+		allErrs = append(allErrs, field.Forbidden(specPath.Child("selector"), "field is immutable"))
+	}
+
+	oldContainers := make(map[string]*appsv1alpha1.SidecarContainer, len(oldObj.Spec.Containers))
+	for i := range oldObj.Spec.Containers {
+		oldContainers[oldObj.Spec.Containers[i].Name] = &oldObj.Spec.Containers[i]
+	}
+	for i := range newObj.Spec.Containers {
+		newContainer := &newObj.Spec.Containers[i]
+		oldContainer, ok := oldContainers[newContainer.Name]
+		if !ok {
+			continue
+		}
+		upgradePath := specPath.Child("containers").Index(i).Child("upgradeStrategy")
+		if newContainer.UpgradeStrategy.UpgradeType != oldContainer.UpgradeStrategy.UpgradeType {
+			allErrs = append(allErrs, field.Forbidden(upgradePath.Child("upgradeType"), "field is immutable"))
+			continue
+		}
+		if newContainer.UpgradeStrategy.UpgradeType == appsv1alpha1.SidecarContainerHotUpgrade &&
+			newObj.GetHotUpgradeEmptyImage(newContainer) != oldObj.GetHotUpgradeEmptyImage(oldContainer) {
+			allErrs = append(allErrs, field.Forbidden(upgradePath.Child("hotUpgradeEmptyImage"), "field is immutable"))
+		}
+	}
+	return allErrs
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	appsv1alpha1 "github.com/openkruise/kruise-api/apps/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateSidecarSetSpecFieldPath(t *testing.T) {
+	spec := &appsv1alpha1.SidecarSetSpec{
+		Selector:          &metav1.LabelSelector{MatchLabels: map[string]string{"app": "foo"}},
+		Namespace:         "default",
+		NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "test"}},
+		Containers: []appsv1alpha1.SidecarContainer{
+			{EnvMergePolicy: "Unknown"},
+		},
+	}
+	spec.Containers[0].Name = "sidecar"
+
+	errs := ValidateSidecarSetSpec(spec, field.NewPath("items").Index(0).Child("spec"))
+	expected := []string{
+		"items[0].spec.namespaceSelector",
+		"items[0].spec.containers[0].envMergePolicy",
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %v", len(expected), errs)
+	}
+	for i, err := range errs {
+		if err.Field != expected[i] {
+			t.Errorf("expected error on %s, got %s", expected[i], err.Field)
+		}
+	}
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	appsv1alpha1 "github.com/openkruise/kruise-api/apps/v1alpha1"
+	apps "k8s.io/api/apps/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateStatefulSetSpec validates the spec of Advanced StatefulSet, fldPath should be the path of spec.
+// It only covers the constraints introduced by Advanced StatefulSet, the pod template should be validated
+// by the caller with the upstream validation of core types.
+func ValidateStatefulSetSpec(spec *appsv1alpha1.StatefulSetSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.Replicas != nil && *spec.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replicas"), *spec.Replicas, "must be greater than or equal to 0"))
+	}
+	if spec.RevisionHistoryLimit != nil && *spec.RevisionHistoryLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("revisionHistoryLimit"), *spec.RevisionHistoryLimit, "must be greater than or equal to 0"))
+	}
+
+	switch spec.PodManagementPolicy {
+	case "", apps.OrderedReadyPodManagement, apps.ParallelPodManagement:
+	default:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("podManagementPolicy"), spec.PodManagementPolicy,
+			fmt.Sprintf("must be '%s' or '%s'", apps.OrderedReadyPodManagement, apps.ParallelPodManagement)))
+	}
+
+	allErrs = append(allErrs, appsv1alpha1.ValidateSelector(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1alpha1.ValidateParallelRequirements(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1alpha1.ValidateInPlaceContainerRefs(spec, fldPath)...)
+	allErrs = append(allErrs, validateUpdateStrategy(&spec.UpdateStrategy, fldPath.Child("updateStrategy"))...)
+	return allErrs
+}
+
+func validateUpdateStrategy(strategy *appsv1alpha1.StatefulSetUpdateStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch strategy.Type {
+	case "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), ""))
+	case apps.OnDeleteStatefulSetStrategyType:
+		if strategy.RollingUpdate != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rollingUpdate"), strategy.RollingUpdate,
+				fmt.Sprintf("only allowed for updateStrategy '%s'", apps.RollingUpdateStatefulSetStrategyType)))
+		}
+	case apps.RollingUpdateStatefulSetStrategyType:
+		if strategy.RollingUpdate != nil {
+			allErrs = append(allErrs, validateRollingUpdate(strategy.RollingUpdate, fldPath.Child("rollingUpdate"))...)
+		}
+	default:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), strategy.Type,
+			fmt.Sprintf("must be '%s' or '%s'", apps.RollingUpdateStatefulSetStrategyType, apps.OnDeleteStatefulSetStrategyType)))
+	}
+	return allErrs
+}
+
+func validateRollingUpdate(rollingUpdate *appsv1alpha1.RollingUpdateStatefulSetStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rollingUpdate.Partition != nil && *rollingUpdate.Partition < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("partition"), *rollingUpdate.Partition, "must be greater than or equal to 0"))
+	}
+	if _, err := rollingUpdate.ResolveMaxUnavailable(1); err != nil {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), rollingUpdate.MaxUnavailable.String(), err.Error()))
+	}
+
+	switch rollingUpdate.PodUpdatePolicy {
+	case "", appsv1alpha1.RecreatePodUpdateStrategyType, appsv1alpha1.InPlaceIfPossiblePodUpdateStrategyType, appsv1alpha1.InPlaceOnlyPodUpdateStrategyType:
+	default:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("podUpdatePolicy"), rollingUpdate.PodUpdatePolicy,
+			fmt.Sprintf("must be '%s', '%s' or '%s'", appsv1alpha1.RecreatePodUpdateStrategyType,
+				appsv1alpha1.InPlaceIfPossiblePodUpdateStrategyType, appsv1alpha1.InPlaceOnlyPodUpdateStrategyType)))
+	}
+
+	if rollingUpdate.MinReadySeconds != nil {
+		if v := *rollingUpdate.MinReadySeconds; v < 0 || v > appsv1alpha1.MaxMinReadySeconds {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("minReadySeconds"), v,
+				fmt.Sprintf("must be in the range of [0, %d]", appsv1alpha1.MaxMinReadySeconds)))
+		}
+	}
+	return allErrs
+}
+
+// ValidateStatefulSetUpdate validates the update of Advanced StatefulSet. Besides the validation of new spec,
+// only 'replicas', 'template', 'updateStrategy' and 'revisionHistoryLimit' are allowed to be changed.
+func ValidateStatefulSetUpdate(newSet, oldSet *appsv1alpha1.StatefulSet) field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := ValidateStatefulSetSpec(&newSet.Spec, specPath)
+
+	newSpec := newSet.Spec.DeepCopy()
+	oldSpec := oldSet.Spec.DeepCopy()
+	newSpec.Replicas = oldSpec.Replicas
+	newSpec.Template = oldSpec.Template
+	newSpec.UpdateStrategy = oldSpec.UpdateStrategy
+	newSpec.RevisionHistoryLimit = oldSpec.RevisionHistoryLimit
+	if !apiequality.Semantic.DeepEqual(newSpec, oldSpec) {
+		allErrs = append(allErrs, field.Forbidden(specPath,
+			"updates to statefulset spec for fields other than 'replicas', 'template', 'updateStrategy' and 'revisionHistoryLimit' are forbidden"))
+	}
+	return allErrs
+}
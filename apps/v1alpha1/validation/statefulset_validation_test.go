@@ -0,0 +1,182 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"strings"
+	"testing"
+
+	appsv1alpha1 "github.com/openkruise/kruise-api/apps/v1alpha1"
+	apps "k8s.io/api/apps/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// The spec embedded in UnitedDeployment should get errors under its own path.
+func TestValidateStatefulSetSpecFieldPath(t *testing.T) {
+	maxUnavailable := intstr.FromInt(1)
+	spec := &appsv1alpha1.StatefulSetSpec{
+		PodManagementPolicy: apps.OrderedReadyPodManagement,
+		UpdateStrategy: appsv1alpha1.StatefulSetUpdateStrategy{
+			Type:          apps.RollingUpdateStatefulSetStrategyType,
+			RollingUpdate: &appsv1alpha1.RollingUpdateStatefulSetStrategy{MaxUnavailable: &maxUnavailable},
+		},
+	}
+
+	fldPath := field.NewPath("spec", "template", "advancedStatefulSetTemplate", "spec")
+	errs := ValidateStatefulSetSpec(spec, fldPath)
+	expected := []string{
+		"spec.template.advancedStatefulSetTemplate.spec.selector",
+		"spec.template.advancedStatefulSetTemplate.spec.updateStrategy.rollingUpdate.maxUnavailable",
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d errors, got %v", len(expected), errs)
+	}
+	for i, err := range errs {
+		if err.Field != expected[i] {
+			t.Errorf("expected error on %s, got %s", expected[i], err.Field)
+		}
+		if !strings.HasPrefix(err.Field, fldPath.String()) {
+			t.Errorf("expected error under %s, got %s", fldPath, err.Field)
+		}
+	}
+}
+
+func TestValidateStatefulSetSpec(t *testing.T) {
+	validSpec := func() *appsv1alpha1.StatefulSetSpec {
+		return &appsv1alpha1.StatefulSetSpec{
+			Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "demo"}},
+			UpdateStrategy: appsv1alpha1.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+		}
+	}
+
+	cases := []struct {
+		name         string
+		modify       func(spec *appsv1alpha1.StatefulSetSpec)
+		expectErrors []string
+	}{
+		{
+			name:   "valid",
+			modify: func(spec *appsv1alpha1.StatefulSetSpec) {},
+		},
+		{
+			name:         "negative replicas",
+			modify:       func(spec *appsv1alpha1.StatefulSetSpec) { spec.Replicas = int32Ptr(-1) },
+			expectErrors: []string{"spec.replicas: Invalid value: -1: must be greater than or equal to 0"},
+		},
+		{
+			name:         "invalid podManagementPolicy",
+			modify:       func(spec *appsv1alpha1.StatefulSetSpec) { spec.PodManagementPolicy = "Foo" },
+			expectErrors: []string{"spec.podManagementPolicy: Invalid value: \"Foo\": must be 'OrderedReady' or 'Parallel'"},
+		},
+		{
+			name:         "missing updateStrategy type",
+			modify:       func(spec *appsv1alpha1.StatefulSetSpec) { spec.UpdateStrategy.Type = "" },
+			expectErrors: []string{"spec.updateStrategy.type: Required value"},
+		},
+		{
+			name: "negative partition",
+			modify: func(spec *appsv1alpha1.StatefulSetSpec) {
+				spec.UpdateStrategy.RollingUpdate = &appsv1alpha1.RollingUpdateStatefulSetStrategy{Partition: int32Ptr(-1)}
+			},
+			expectErrors: []string{"spec.updateStrategy.rollingUpdate.partition: Invalid value: -1: must be greater than or equal to 0"},
+		},
+		{
+			name: "minReadySeconds out of range",
+			modify: func(spec *appsv1alpha1.StatefulSetSpec) {
+				spec.UpdateStrategy.RollingUpdate = &appsv1alpha1.RollingUpdateStatefulSetStrategy{MinReadySeconds: int32Ptr(301)}
+			},
+			expectErrors: []string{"spec.updateStrategy.rollingUpdate.minReadySeconds: Invalid value: 301: must be in the range of [0, 300]"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := validSpec()
+			tc.modify(spec)
+			errs := ValidateStatefulSetSpec(spec, field.NewPath("spec"))
+			if len(errs) != len(tc.expectErrors) {
+				t.Fatalf("expected %d errors, got %v", len(tc.expectErrors), errs)
+			}
+			for i, err := range errs {
+				if err.Error() != tc.expectErrors[i] {
+					t.Errorf("expected error %q, got %q", tc.expectErrors[i], err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStatefulSetUpdate(t *testing.T) {
+	oldSet := &appsv1alpha1.StatefulSet{
+		Spec: appsv1alpha1.StatefulSetSpec{
+			Replicas:       int32Ptr(3),
+			Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "demo"}},
+			ServiceName:    "demo",
+			UpdateStrategy: appsv1alpha1.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+		},
+	}
+
+	cases := []struct {
+		name         string
+		modify       func(set *appsv1alpha1.StatefulSet)
+		expectErrors []string
+	}{
+		{
+			name: "allowed fields changed",
+			modify: func(set *appsv1alpha1.StatefulSet) {
+				set.Spec.Replicas = int32Ptr(5)
+				set.Spec.RevisionHistoryLimit = int32Ptr(5)
+				set.Spec.Template.Labels = map[string]string{"app": "demo"}
+				set.Spec.UpdateStrategy.Type = apps.OnDeleteStatefulSetStrategyType
+			},
+		},
+		{
+			name:   "serviceName changed",
+			modify: func(set *appsv1alpha1.StatefulSet) { set.Spec.ServiceName = "other" },
+			expectErrors: []string{"spec: Forbidden: updates to statefulset spec for fields other than " +
+				"'replicas', 'template', 'updateStrategy' and 'revisionHistoryLimit' are forbidden"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			newSet := oldSet.DeepCopy()
+			tc.modify(newSet)
+			expectedNewSet := newSet.DeepCopy()
+
+			errs := ValidateStatefulSetUpdate(newSet, oldSet)
+			if len(errs) != len(tc.expectErrors) {
+				t.Fatalf("expected %d errors, got %v", len(tc.expectErrors), errs)
+			}
+			for i, err := range errs {
+				if err.Error() != tc.expectErrors[i] {
+					t.Errorf("expected error %q, got %q", tc.expectErrors[i], err.Error())
+				}
+			}
+			if !apiequality.Semantic.DeepEqual(newSet, expectedNewSet) {
+				t.Fatalf("expected new StatefulSet not modified, got %v", newSet)
+			}
+		})
+	}
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
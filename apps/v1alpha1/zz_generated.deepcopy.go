@@ -57,6 +57,22 @@ func (in *AdvancedCronJob) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdvancedCronJobCondition) DeepCopyInto(out *AdvancedCronJobCondition) {
+	*out = *in
+	in.LastTransitionTime.DeepCopyInto(&out.LastTransitionTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvancedCronJobCondition.
+func (in *AdvancedCronJobCondition) DeepCopy() *AdvancedCronJobCondition {
+	if in == nil {
+		return nil
+	}
+	out := new(AdvancedCronJobCondition)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdvancedCronJobList) DeepCopyInto(out *AdvancedCronJobList) {
 	*out = *in
@@ -89,6 +105,46 @@ func (in *AdvancedCronJobList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdvancedCronJobNotification) DeepCopyInto(out *AdvancedCronJobNotification) {
+	*out = *in
+	if in.HeadersSecretRef != nil {
+		in, out := &in.HeadersSecretRef, &out.HeadersSecretRef
+		*out = new(v1.LocalObjectReference)
+		**out = **in
+	}
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]AdvancedCronJobNotificationEvent, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvancedCronJobNotification.
+func (in *AdvancedCronJobNotification) DeepCopy() *AdvancedCronJobNotification {
+	if in == nil {
+		return nil
+	}
+	out := new(AdvancedCronJobNotification)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AdvancedCronJobRun) DeepCopyInto(out *AdvancedCronJobRun) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvancedCronJobRun.
+func (in *AdvancedCronJobRun) DeepCopy() *AdvancedCronJobRun {
+	if in == nil {
+		return nil
+	}
+	out := new(AdvancedCronJobRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AdvancedCronJobSpec) DeepCopyInto(out *AdvancedCronJobSpec) {
 	*out = *in
@@ -102,6 +158,15 @@ func (in *AdvancedCronJobSpec) DeepCopyInto(out *AdvancedCronJobSpec) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SuspendUntil != nil {
+		in, out := &in.SuspendUntil, &out.SuspendUntil
+		*out = (*in).DeepCopy()
+	}
+	if in.Notification != nil {
+		in, out := &in.Notification, &out.Notification
+		*out = new(AdvancedCronJobNotification)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.SuccessfulJobsHistoryLimit != nil {
 		in, out := &in.SuccessfulJobsHistoryLimit, &out.SuccessfulJobsHistoryLimit
 		*out = new(int32)
@@ -113,6 +178,11 @@ func (in *AdvancedCronJobSpec) DeepCopyInto(out *AdvancedCronJobSpec) {
 		**out = **in
 	}
 	in.Template.DeepCopyInto(&out.Template)
+	if in.TimeZone != nil {
+		in, out := &in.TimeZone, &out.TimeZone
+		*out = new(string)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvancedCronJobSpec.
@@ -137,6 +207,26 @@ func (in *AdvancedCronJobStatus) DeepCopyInto(out *AdvancedCronJobStatus) {
 		in, out := &in.LastScheduleTime, &out.LastScheduleTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastSuccessfulTime != nil {
+		in, out := &in.LastSuccessfulTime, &out.LastSuccessfulTime
+		*out = (*in).DeepCopy()
+	}
+	if in.LastFailedTime != nil {
+		in, out := &in.LastFailedTime, &out.LastFailedTime
+		*out = (*in).DeepCopy()
+	}
+	if in.RecentRuns != nil {
+		in, out := &in.RecentRuns, &out.RecentRuns
+		*out = make([]AdvancedCronJobRun, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]AdvancedCronJobCondition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AdvancedCronJobStatus.
@@ -225,6 +315,51 @@ func (in *BroadcastJobList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BroadcastJobNodeSelection) DeepCopyInto(out *BroadcastJobNodeSelection) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ExcludeNames != nil {
+		in, out := &in.ExcludeNames, &out.ExcludeNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BroadcastJobNodeSelection.
+func (in *BroadcastJobNodeSelection) DeepCopy() *BroadcastJobNodeSelection {
+	if in == nil {
+		return nil
+	}
+	out := new(BroadcastJobNodeSelection)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BroadcastJobNodeStatus) DeepCopyInto(out *BroadcastJobNodeStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BroadcastJobNodeStatus.
+func (in *BroadcastJobNodeStatus) DeepCopy() *BroadcastJobNodeStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BroadcastJobNodeStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *BroadcastJobSpec) DeepCopyInto(out *BroadcastJobSpec) {
 	*out = *in
@@ -236,6 +371,16 @@ func (in *BroadcastJobSpec) DeepCopyInto(out *BroadcastJobSpec) {
 	in.Template.DeepCopyInto(&out.Template)
 	in.CompletionPolicy.DeepCopyInto(&out.CompletionPolicy)
 	out.FailurePolicy = in.FailurePolicy
+	if in.PodActiveDeadlineSeconds != nil {
+		in, out := &in.PodActiveDeadlineSeconds, &out.PodActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.NodeSelection != nil {
+		in, out := &in.NodeSelection, &out.NodeSelection
+		*out = new(BroadcastJobNodeSelection)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BroadcastJobSpec.
@@ -266,6 +411,11 @@ func (in *BroadcastJobStatus) DeepCopyInto(out *BroadcastJobStatus) {
 		in, out := &in.CompletionTime, &out.CompletionTime
 		*out = (*in).DeepCopy()
 	}
+	if in.NodeStatuses != nil {
+		in, out := &in.NodeStatuses, &out.NodeStatuses
+		*out = make([]BroadcastJobNodeStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BroadcastJobStatus.
@@ -370,6 +520,21 @@ func (in *CloneSetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloneSetRevisionReplicas) DeepCopyInto(out *CloneSetRevisionReplicas) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloneSetRevisionReplicas.
+func (in *CloneSetRevisionReplicas) DeepCopy() *CloneSetRevisionReplicas {
+	if in == nil {
+		return nil
+	}
+	out := new(CloneSetRevisionReplicas)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloneSetScaleStrategy) DeepCopyInto(out *CloneSetScaleStrategy) {
 	*out = *in
@@ -378,6 +543,11 @@ func (in *CloneSetScaleStrategy) DeepCopyInto(out *CloneSetScaleStrategy) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.MaxUnavailable != nil {
+		in, out := &in.MaxUnavailable, &out.MaxUnavailable
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloneSetScaleStrategy.
@@ -390,6 +560,21 @@ func (in *CloneSetScaleStrategy) DeepCopy() *CloneSetScaleStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloneSetScaleTemplateSpec) DeepCopyInto(out *CloneSetScaleTemplateSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloneSetScaleTemplateSpec.
+func (in *CloneSetScaleTemplateSpec) DeepCopy() *CloneSetScaleTemplateSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CloneSetScaleTemplateSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloneSetSpec) DeepCopyInto(out *CloneSetSpec) {
 	*out = *in
@@ -423,6 +608,7 @@ func (in *CloneSetSpec) DeepCopyInto(out *CloneSetSpec) {
 		*out = new(pub.Lifecycle)
 		(*in).DeepCopyInto(*out)
 	}
+	out.VolumeClaimUpdateStrategy = in.VolumeClaimUpdateStrategy
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloneSetSpec.
@@ -450,6 +636,16 @@ func (in *CloneSetStatus) DeepCopyInto(out *CloneSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.VolumeClaims != nil {
+		in, out := &in.VolumeClaims, &out.VolumeClaims
+		*out = make([]CloneSetVolumeClaimStatus, len(*in))
+		copy(*out, *in)
+	}
+	if in.RevisionReplicas != nil {
+		in, out := &in.RevisionReplicas, &out.RevisionReplicas
+		*out = make([]CloneSetRevisionReplicas, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloneSetStatus.
@@ -487,6 +683,11 @@ func (in *CloneSetUpdateStrategy) DeepCopyInto(out *CloneSetUpdateStrategy) {
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.PartitionSelector != nil {
+		in, out := &in.PartitionSelector, &out.PartitionSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.MaxUnavailable != nil {
 		in, out := &in.MaxUnavailable, &out.MaxUnavailable
 		*out = new(intstr.IntOrString)
@@ -510,7 +711,7 @@ func (in *CloneSetUpdateStrategy) DeepCopyInto(out *CloneSetUpdateStrategy) {
 	if in.InPlaceUpdateStrategy != nil {
 		in, out := &in.InPlaceUpdateStrategy, &out.InPlaceUpdateStrategy
 		*out = new(pub.InPlaceUpdateStrategy)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 }
 
@@ -524,6 +725,36 @@ func (in *CloneSetUpdateStrategy) DeepCopy() *CloneSetUpdateStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloneSetVolumeClaimStatus) DeepCopyInto(out *CloneSetVolumeClaimStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloneSetVolumeClaimStatus.
+func (in *CloneSetVolumeClaimStatus) DeepCopy() *CloneSetVolumeClaimStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(CloneSetVolumeClaimStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CloneSetVolumeClaimUpdateStrategy) DeepCopyInto(out *CloneSetVolumeClaimUpdateStrategy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CloneSetVolumeClaimUpdateStrategy.
+func (in *CloneSetVolumeClaimUpdateStrategy) DeepCopy() *CloneSetVolumeClaimUpdateStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(CloneSetVolumeClaimUpdateStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CompletionPolicy) DeepCopyInto(out *CompletionPolicy) {
 	*out = *in
@@ -782,6 +1013,11 @@ func (in *CronJobTemplate) DeepCopyInto(out *CronJobTemplate) {
 		*out = new(BroadcastJobTemplateSpec)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.CloneSetScaleTemplate != nil {
+		in, out := &in.CloneSetScaleTemplate, &out.CloneSetScaleTemplate
+		*out = new(CloneSetScaleTemplateSpec)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CronJobTemplate.
@@ -869,6 +1105,21 @@ func (in *DaemonSetList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DaemonSetNodePoolStatus) DeepCopyInto(out *DaemonSetNodePoolStatus) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DaemonSetNodePoolStatus.
+func (in *DaemonSetNodePoolStatus) DeepCopy() *DaemonSetNodePoolStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(DaemonSetNodePoolStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DaemonSetSpec) DeepCopyInto(out *DaemonSetSpec) {
 	*out = *in
@@ -889,6 +1140,18 @@ func (in *DaemonSetSpec) DeepCopyInto(out *DaemonSetSpec) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.Lifecycle != nil {
+		in, out := &in.Lifecycle, &out.Lifecycle
+		*out = new(pub.Lifecycle)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.IgnoredTaints != nil {
+		in, out := &in.IgnoredTaints, &out.IgnoredTaints
+		*out = make([]v1.Toleration, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DaemonSetSpec.
@@ -916,6 +1179,11 @@ func (in *DaemonSetStatus) DeepCopyInto(out *DaemonSetStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NodePools != nil {
+		in, out := &in.NodePools, &out.NodePools
+		*out = make([]DaemonSetNodePoolStatus, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DaemonSetStatus.
@@ -936,6 +1204,11 @@ func (in *DaemonSetUpdateStrategy) DeepCopyInto(out *DaemonSetUpdateStrategy) {
 		*out = new(RollingUpdateDaemonSet)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.InPlaceUpdateStrategy != nil {
+		in, out := &in.InPlaceUpdateStrategy, &out.InPlaceUpdateStrategy
+		*out = new(pub.InPlaceUpdateStrategy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DaemonSetUpdateStrategy.
@@ -1486,6 +1759,11 @@ func (in *RollingUpdateDaemonSet) DeepCopyInto(out *RollingUpdateDaemonSet) {
 		*out = new(int32)
 		**out = **in
 	}
+	if in.PartitionPercent != nil {
+		in, out := &in.PartitionPercent, &out.PartitionPercent
+		*out = new(intstr.IntOrString)
+		**out = **in
+	}
 	if in.Paused != nil {
 		in, out := &in.Paused, &out.Paused
 		*out = new(bool)
@@ -1496,6 +1774,11 @@ func (in *RollingUpdateDaemonSet) DeepCopyInto(out *RollingUpdateDaemonSet) {
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.Progressive != nil {
+		in, out := &in.Progressive, &out.Progressive
+		*out = new(bool)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RollingUpdateDaemonSet.
@@ -1529,7 +1812,7 @@ func (in *RollingUpdateStatefulSetStrategy) DeepCopyInto(out *RollingUpdateState
 	if in.InPlaceUpdateStrategy != nil {
 		in, out := &in.InPlaceUpdateStrategy, &out.InPlaceUpdateStrategy
 		*out = new(pub.InPlaceUpdateStrategy)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.MinReadySeconds != nil {
 		in, out := &in.MinReadySeconds, &out.MinReadySeconds
@@ -1569,10 +1852,17 @@ func (in *SidecarContainer) DeepCopyInto(out *SidecarContainer) {
 	in.Container.DeepCopyInto(&out.Container)
 	out.UpgradeStrategy = in.UpgradeStrategy
 	out.ShareVolumePolicy = in.ShareVolumePolicy
+	if in.VolumePolicy != nil {
+		in, out := &in.VolumePolicy, &out.VolumePolicy
+		*out = new(SidecarVolumePolicy)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.TransferEnv != nil {
 		in, out := &in.TransferEnv, &out.TransferEnv
 		*out = make([]TransferEnvVar, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
@@ -1607,7 +1897,7 @@ func (in *SidecarSet) DeepCopyInto(out *SidecarSet) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
-	out.Status = in.Status
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarSet.
@@ -1628,6 +1918,51 @@ func (in *SidecarSet) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SidecarSetInjectRevision) DeepCopyInto(out *SidecarSetInjectRevision) {
+	*out = *in
+	if in.CustomVersion != nil {
+		in, out := &in.CustomVersion, &out.CustomVersion
+		*out = new(string)
+		**out = **in
+	}
+	if in.RevisionName != nil {
+		in, out := &in.RevisionName, &out.RevisionName
+		*out = new(string)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarSetInjectRevision.
+func (in *SidecarSetInjectRevision) DeepCopy() *SidecarSetInjectRevision {
+	if in == nil {
+		return nil
+	}
+	out := new(SidecarSetInjectRevision)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SidecarSetInjectionStrategy) DeepCopyInto(out *SidecarSetInjectionStrategy) {
+	*out = *in
+	if in.Revision != nil {
+		in, out := &in.Revision, &out.Revision
+		*out = new(SidecarSetInjectRevision)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarSetInjectionStrategy.
+func (in *SidecarSetInjectionStrategy) DeepCopy() *SidecarSetInjectionStrategy {
+	if in == nil {
+		return nil
+	}
+	out := new(SidecarSetInjectionStrategy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SidecarSetList) DeepCopyInto(out *SidecarSetList) {
 	*out = *in
@@ -1668,6 +2003,11 @@ func (in *SidecarSetSpec) DeepCopyInto(out *SidecarSetSpec) {
 		*out = new(metav1.LabelSelector)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(metav1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.InitContainers != nil {
 		in, out := &in.InitContainers, &out.InitContainers
 		*out = make([]SidecarContainer, len(*in))
@@ -1690,6 +2030,7 @@ func (in *SidecarSetSpec) DeepCopyInto(out *SidecarSetSpec) {
 		}
 	}
 	in.UpdateStrategy.DeepCopyInto(&out.UpdateStrategy)
+	in.InjectionStrategy.DeepCopyInto(&out.InjectionStrategy)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarSetSpec.
@@ -1705,6 +2046,16 @@ func (in *SidecarSetSpec) DeepCopy() *SidecarSetSpec {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SidecarSetStatus) DeepCopyInto(out *SidecarSetStatus) {
 	*out = *in
+	if in.CollisionCount != nil {
+		in, out := &in.CollisionCount, &out.CollisionCount
+		*out = new(int32)
+		**out = **in
+	}
+	if in.Details != nil {
+		in, out := &in.Details, &out.Details
+		*out = make([]SidecarSetStatusDetail, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarSetStatus.
@@ -1717,6 +2068,21 @@ func (in *SidecarSetStatus) DeepCopy() *SidecarSetStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SidecarSetStatusDetail) DeepCopyInto(out *SidecarSetStatusDetail) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarSetStatusDetail.
+func (in *SidecarSetStatusDetail) DeepCopy() *SidecarSetStatusDetail {
+	if in == nil {
+		return nil
+	}
+	out := new(SidecarSetStatusDetail)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *SidecarSetUpdateStrategy) DeepCopyInto(out *SidecarSetUpdateStrategy) {
 	*out = *in
@@ -1752,6 +2118,51 @@ func (in *SidecarSetUpdateStrategy) DeepCopy() *SidecarSetUpdateStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SidecarTerminator) DeepCopyInto(out *SidecarTerminator) {
+	*out = *in
+	if in.SidecarContainers != nil {
+		in, out := &in.SidecarContainers, &out.SidecarContainers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.MainContainers != nil {
+		in, out := &in.MainContainers, &out.MainContainers
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarTerminator.
+func (in *SidecarTerminator) DeepCopy() *SidecarTerminator {
+	if in == nil {
+		return nil
+	}
+	out := new(SidecarTerminator)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SidecarVolumePolicy) DeepCopyInto(out *SidecarVolumePolicy) {
+	*out = *in
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SidecarVolumePolicy.
+func (in *SidecarVolumePolicy) DeepCopy() *SidecarVolumePolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(SidecarVolumePolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *StatefulSet) DeepCopyInto(out *StatefulSet) {
 	*out = *in
@@ -2018,6 +2429,11 @@ func (in *Topology) DeepCopy() *Topology {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *TransferEnvVar) DeepCopyInto(out *TransferEnvVar) {
 	*out = *in
+	if in.SourceFrom != nil {
+		in, out := &in.SourceFrom, &out.SourceFrom
+		*out = new(TransferEnvVarSource)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransferEnvVar.
@@ -2030,6 +2446,26 @@ func (in *TransferEnvVar) DeepCopy() *TransferEnvVar {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TransferEnvVarSource) DeepCopyInto(out *TransferEnvVarSource) {
+	*out = *in
+	if in.FieldRef != nil {
+		in, out := &in.FieldRef, &out.FieldRef
+		*out = new(v1.ObjectFieldSelector)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TransferEnvVarSource.
+func (in *TransferEnvVarSource) DeepCopy() *TransferEnvVarSource {
+	if in == nil {
+		return nil
+	}
+	out := new(TransferEnvVarSource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *UnitedDeployment) DeepCopyInto(out *UnitedDeployment) {
 	*out = *in
@@ -0,0 +1,245 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/openkruise/kruise-api/apps/v1alpha1"
+	"k8s.io/apimachinery/pkg/conversion"
+)
+
+// BroadcastJobObservedGenerationAnnotation is the annotation on a v1alpha1 BroadcastJob that keeps
+// status.observedGeneration of v1beta1, so that it can be restored when converting back to v1beta1.
+const BroadcastJobObservedGenerationAnnotation = "apps.kruise.io/broadcastjob-observed-generation"
+
+// BroadcastJob in v1beta1 has the same spec as v1alpha1, but the status is restructured with the phase in
+// PascalCase and the conditions in BroadcastJobCondition. status.observedGeneration only exists in v1beta1,
+// and it is kept in BroadcastJobObservedGenerationAnnotation of v1alpha1.
+// Like the CloneSet ones, these functions share the memory of pointers, slices and maps between in and out
+// where the element types are the same.
+
+var broadcastJobPhasesFromV1alpha1 = map[v1alpha1.BroadcastJobPhase]BroadcastJobPhase{
+	v1alpha1.PhaseRunning:   BroadcastJobPhaseRunning,
+	v1alpha1.PhasePaused:    BroadcastJobPhasePaused,
+	v1alpha1.PhaseCompleted: BroadcastJobPhaseCompleted,
+	v1alpha1.PhaseFailed:    BroadcastJobPhaseFailed,
+}
+
+// Convert_v1alpha1_BroadcastJob_To_v1beta1_BroadcastJob converts v1alpha1 BroadcastJob to v1beta1.
+func Convert_v1alpha1_BroadcastJob_To_v1beta1_BroadcastJob(in *v1alpha1.BroadcastJob, out *BroadcastJob, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	out.TypeMeta.APIVersion = SchemeGroupVersion.String()
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_BroadcastJobSpec_To_v1beta1_BroadcastJobSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_BroadcastJobStatus_To_v1beta1_BroadcastJobStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+
+	value, ok := in.Annotations[BroadcastJobObservedGenerationAnnotation]
+	if !ok {
+		return nil
+	}
+	observedGeneration, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return fmt.Errorf("failed to parse annotation %s: %v", BroadcastJobObservedGenerationAnnotation, err)
+	}
+	out.Status.ObservedGeneration = observedGeneration
+	out.Annotations = withoutAnnotation(in.Annotations, BroadcastJobObservedGenerationAnnotation)
+	return nil
+}
+
+// Convert_v1beta1_BroadcastJob_To_v1alpha1_BroadcastJob converts v1beta1 BroadcastJob to v1alpha1.
+func Convert_v1beta1_BroadcastJob_To_v1alpha1_BroadcastJob(in *BroadcastJob, out *v1alpha1.BroadcastJob, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	out.TypeMeta.APIVersion = v1alpha1.SchemeGroupVersion.String()
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_BroadcastJobSpec_To_v1alpha1_BroadcastJobSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_BroadcastJobStatus_To_v1alpha1_BroadcastJobStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+
+	if in.Status.ObservedGeneration == 0 {
+		out.Annotations = withoutAnnotation(in.Annotations, BroadcastJobObservedGenerationAnnotation)
+	} else {
+		out.Annotations = withAnnotation(in.Annotations, BroadcastJobObservedGenerationAnnotation,
+			strconv.FormatInt(in.Status.ObservedGeneration, 10))
+	}
+	return nil
+}
+
+// Convert_v1alpha1_BroadcastJobList_To_v1beta1_BroadcastJobList converts v1alpha1 BroadcastJobList to v1beta1.
+func Convert_v1alpha1_BroadcastJobList_To_v1beta1_BroadcastJobList(in *v1alpha1.BroadcastJobList, out *BroadcastJobList, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	out.TypeMeta.APIVersion = SchemeGroupVersion.String()
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]BroadcastJob, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1alpha1_BroadcastJob_To_v1beta1_BroadcastJob(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert_v1beta1_BroadcastJobList_To_v1alpha1_BroadcastJobList converts v1beta1 BroadcastJobList to v1alpha1.
+func Convert_v1beta1_BroadcastJobList_To_v1alpha1_BroadcastJobList(in *BroadcastJobList, out *v1alpha1.BroadcastJobList, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	out.TypeMeta.APIVersion = v1alpha1.SchemeGroupVersion.String()
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]v1alpha1.BroadcastJob, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_BroadcastJob_To_v1alpha1_BroadcastJob(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert_v1alpha1_BroadcastJobSpec_To_v1beta1_BroadcastJobSpec converts v1alpha1 BroadcastJobSpec to v1beta1.
+func Convert_v1alpha1_BroadcastJobSpec_To_v1beta1_BroadcastJobSpec(in *v1alpha1.BroadcastJobSpec, out *BroadcastJobSpec, s conversion.Scope) error {
+	out.Parallelism = in.Parallelism
+	out.Template = in.Template
+	out.CompletionPolicy = CompletionPolicy{
+		Type:                    CompletionPolicyType(in.CompletionPolicy.Type),
+		ActiveDeadlineSeconds:   in.CompletionPolicy.ActiveDeadlineSeconds,
+		TTLSecondsAfterFinished: in.CompletionPolicy.TTLSecondsAfterFinished,
+	}
+	out.Paused = in.Paused
+	out.FailurePolicy = FailurePolicy{
+		Type:         FailurePolicyType(in.FailurePolicy.Type),
+		RestartLimit: in.FailurePolicy.RestartLimit,
+	}
+	out.PodActiveDeadlineSeconds = in.PodActiveDeadlineSeconds
+	out.NodeSelection = (*BroadcastJobNodeSelection)(in.NodeSelection)
+	return nil
+}
+
+// Convert_v1beta1_BroadcastJobSpec_To_v1alpha1_BroadcastJobSpec converts v1beta1 BroadcastJobSpec to v1alpha1.
+func Convert_v1beta1_BroadcastJobSpec_To_v1alpha1_BroadcastJobSpec(in *BroadcastJobSpec, out *v1alpha1.BroadcastJobSpec, s conversion.Scope) error {
+	out.Parallelism = in.Parallelism
+	out.Template = in.Template
+	out.CompletionPolicy = v1alpha1.CompletionPolicy{
+		Type:                    v1alpha1.CompletionPolicyType(in.CompletionPolicy.Type),
+		ActiveDeadlineSeconds:   in.CompletionPolicy.ActiveDeadlineSeconds,
+		TTLSecondsAfterFinished: in.CompletionPolicy.TTLSecondsAfterFinished,
+	}
+	out.Paused = in.Paused
+	out.FailurePolicy = v1alpha1.FailurePolicy{
+		Type:         v1alpha1.FailurePolicyType(in.FailurePolicy.Type),
+		RestartLimit: in.FailurePolicy.RestartLimit,
+	}
+	out.PodActiveDeadlineSeconds = in.PodActiveDeadlineSeconds
+	out.NodeSelection = (*v1alpha1.BroadcastJobNodeSelection)(in.NodeSelection)
+	return nil
+}
+
+// Convert_v1alpha1_BroadcastJobStatus_To_v1beta1_BroadcastJobStatus converts v1alpha1 BroadcastJobStatus to v1beta1.
+// The unknown phases are kept as they are.
+func Convert_v1alpha1_BroadcastJobStatus_To_v1beta1_BroadcastJobStatus(in *v1alpha1.BroadcastJobStatus, out *BroadcastJobStatus, s conversion.Scope) error {
+	if phase, ok := broadcastJobPhasesFromV1alpha1[in.Phase]; ok {
+		out.Phase = phase
+	} else {
+		out.Phase = BroadcastJobPhase(in.Phase)
+	}
+	if in.Conditions != nil {
+		out.Conditions = make([]BroadcastJobCondition, len(in.Conditions))
+		for i, c := range in.Conditions {
+			out.Conditions[i] = BroadcastJobCondition{
+				Type:               BroadcastJobConditionType(c.Type),
+				Status:             c.Status,
+				LastProbeTime:      c.LastProbeTime,
+				LastTransitionTime: c.LastTransitionTime,
+				Reason:             c.Reason,
+				Message:            c.Message,
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.StartTime = in.StartTime
+	out.CompletionTime = in.CompletionTime
+	out.Desired = in.Desired
+	out.Active = in.Active
+	out.Succeeded = in.Succeeded
+	out.Failed = in.Failed
+	if in.NodeStatuses != nil {
+		out.NodeStatuses = make([]BroadcastJobNodeStatus, len(in.NodeStatuses))
+		for i, n := range in.NodeStatuses {
+			out.NodeStatuses[i] = BroadcastJobNodeStatus(n)
+		}
+	} else {
+		out.NodeStatuses = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_BroadcastJobStatus_To_v1alpha1_BroadcastJobStatus converts v1beta1 BroadcastJobStatus to v1alpha1.
+// The unknown phases are kept as they are. observedGeneration is not converted here,
+// Convert_v1beta1_BroadcastJob_To_v1alpha1_BroadcastJob keeps it in BroadcastJobObservedGenerationAnnotation.
+func Convert_v1beta1_BroadcastJobStatus_To_v1alpha1_BroadcastJobStatus(in *BroadcastJobStatus, out *v1alpha1.BroadcastJobStatus, s conversion.Scope) error {
+	out.Phase = v1alpha1.BroadcastJobPhase(in.Phase)
+	for alphaPhase, betaPhase := range broadcastJobPhasesFromV1alpha1 {
+		if betaPhase == in.Phase {
+			out.Phase = alphaPhase
+			break
+		}
+	}
+	if in.Conditions != nil {
+		out.Conditions = make([]v1alpha1.JobCondition, len(in.Conditions))
+		for i, c := range in.Conditions {
+			out.Conditions[i] = v1alpha1.JobCondition{
+				Type:               v1alpha1.JobConditionType(c.Type),
+				Status:             c.Status,
+				LastProbeTime:      c.LastProbeTime,
+				LastTransitionTime: c.LastTransitionTime,
+				Reason:             c.Reason,
+				Message:            c.Message,
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.StartTime = in.StartTime
+	out.CompletionTime = in.CompletionTime
+	out.Desired = in.Desired
+	out.Active = in.Active
+	out.Succeeded = in.Succeeded
+	out.Failed = in.Failed
+	if in.NodeStatuses != nil {
+		out.NodeStatuses = make([]v1alpha1.BroadcastJobNodeStatus, len(in.NodeStatuses))
+		for i, n := range in.NodeStatuses {
+			out.NodeStatuses[i] = v1alpha1.BroadcastJobNodeStatus(n)
+		}
+	} else {
+		out.NodeStatuses = nil
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/openkruise/kruise-api/apps/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+)
+
+func TestBroadcastJobPhaseConversion(t *testing.T) {
+	cases := []struct {
+		alphaPhase v1alpha1.BroadcastJobPhase
+		betaPhase  BroadcastJobPhase
+	}{
+		{alphaPhase: v1alpha1.PhaseRunning, betaPhase: BroadcastJobPhaseRunning},
+		{alphaPhase: v1alpha1.PhasePaused, betaPhase: BroadcastJobPhasePaused},
+		{alphaPhase: v1alpha1.PhaseCompleted, betaPhase: BroadcastJobPhaseCompleted},
+		{alphaPhase: v1alpha1.PhaseFailed, betaPhase: BroadcastJobPhaseFailed},
+		{alphaPhase: "", betaPhase: ""},
+		{alphaPhase: "unknown", betaPhase: "unknown"},
+	}
+
+	for _, tc := range cases {
+		t.Run(string(tc.betaPhase), func(t *testing.T) {
+			betaStatus := &BroadcastJobStatus{}
+			if err := Convert_v1alpha1_BroadcastJobStatus_To_v1beta1_BroadcastJobStatus(&v1alpha1.BroadcastJobStatus{Phase: tc.alphaPhase}, betaStatus, nil); err != nil {
+				t.Fatalf("failed to convert to v1beta1: %v", err)
+			}
+			if betaStatus.Phase != tc.betaPhase {
+				t.Fatalf("expected v1beta1 phase %q, got %q", tc.betaPhase, betaStatus.Phase)
+			}
+
+			alphaStatus := &v1alpha1.BroadcastJobStatus{}
+			if err := Convert_v1beta1_BroadcastJobStatus_To_v1alpha1_BroadcastJobStatus(&BroadcastJobStatus{Phase: tc.betaPhase}, alphaStatus, nil); err != nil {
+				t.Fatalf("failed to convert to v1alpha1: %v", err)
+			}
+			if alphaStatus.Phase != tc.alphaPhase {
+				t.Fatalf("expected v1alpha1 phase %q, got %q", tc.alphaPhase, alphaStatus.Phase)
+			}
+		})
+	}
+}
+
+func TestBroadcastJobConversionRoundTrip(t *testing.T) {
+	phases := []v1alpha1.BroadcastJobPhase{
+		v1alpha1.PhaseRunning, v1alpha1.PhasePaused, v1alpha1.PhaseCompleted, v1alpha1.PhaseFailed, "", "unknown",
+	}
+	f := fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(1), serializer.NewCodecFactory(runtime.NewScheme()))
+	for i := 0; i < conversionFuzzIters; i++ {
+		original := &v1alpha1.BroadcastJob{}
+		f.Fuzz(original)
+		original.APIVersion = v1alpha1.SchemeGroupVersion.String()
+		original.Status.Phase = phases[i%len(phases)]
+
+		converted := &BroadcastJob{}
+		if err := Convert_v1alpha1_BroadcastJob_To_v1beta1_BroadcastJob(original.DeepCopy(), converted, nil); err != nil {
+			t.Fatalf("failed to convert to v1beta1: %v", err)
+		}
+		if converted.APIVersion != SchemeGroupVersion.String() {
+			t.Fatalf("expected apiVersion %s, got %s", SchemeGroupVersion.String(), converted.APIVersion)
+		}
+		roundTripped := &v1alpha1.BroadcastJob{}
+		if err := Convert_v1beta1_BroadcastJob_To_v1alpha1_BroadcastJob(converted, roundTripped, nil); err != nil {
+			t.Fatalf("failed to convert back to v1alpha1: %v", err)
+		}
+		if !apiequality.Semantic.DeepEqual(original, roundTripped) {
+			t.Fatalf("expected BroadcastJob unchanged after round trip, diff: %s", diff.ObjectReflectDiff(original, roundTripped))
+		}
+	}
+}
+
+func TestBroadcastJobConversionRoundTripFromV1beta1(t *testing.T) {
+	phases := []BroadcastJobPhase{
+		BroadcastJobPhaseRunning, BroadcastJobPhasePaused, BroadcastJobPhaseCompleted, BroadcastJobPhaseFailed, "", "unknown",
+	}
+	f := fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(1), serializer.NewCodecFactory(runtime.NewScheme()))
+	for i := 0; i < conversionFuzzIters; i++ {
+		original := &BroadcastJob{}
+		f.Fuzz(original)
+		original.APIVersion = SchemeGroupVersion.String()
+		original.Status.Phase = phases[i%len(phases)]
+
+		converted := &v1alpha1.BroadcastJob{}
+		if err := Convert_v1beta1_BroadcastJob_To_v1alpha1_BroadcastJob(original.DeepCopy(), converted, nil); err != nil {
+			t.Fatalf("failed to convert to v1alpha1: %v", err)
+		}
+		roundTripped := &BroadcastJob{}
+		if err := Convert_v1alpha1_BroadcastJob_To_v1beta1_BroadcastJob(converted, roundTripped, nil); err != nil {
+			t.Fatalf("failed to convert back to v1beta1: %v", err)
+		}
+		if !apiequality.Semantic.DeepEqual(original, roundTripped) {
+			t.Fatalf("expected BroadcastJob unchanged after round trip, diff: %s", diff.ObjectReflectDiff(original, roundTripped))
+		}
+	}
+}
+
+func TestBroadcastJobConversionObservedGeneration(t *testing.T) {
+	in := &BroadcastJob{}
+	in.Status.ObservedGeneration = 5
+
+	out := &v1alpha1.BroadcastJob{}
+	if err := Convert_v1beta1_BroadcastJob_To_v1alpha1_BroadcastJob(in, out, nil); err != nil {
+		t.Fatalf("failed to convert to v1alpha1: %v", err)
+	}
+	if value := out.Annotations[BroadcastJobObservedGenerationAnnotation]; value != "5" {
+		t.Fatalf("expected annotation %s to be 5, got %q", BroadcastJobObservedGenerationAnnotation, value)
+	}
+	if in.Annotations != nil {
+		t.Fatalf("expected input annotations unchanged, got %v", in.Annotations)
+	}
+
+	out.Annotations[BroadcastJobObservedGenerationAnnotation] = "abc"
+	if err := Convert_v1alpha1_BroadcastJob_To_v1beta1_BroadcastJob(out, &BroadcastJob{}, nil); err == nil {
+		t.Fatalf("expected error for invalid annotation, got nil")
+	}
+}
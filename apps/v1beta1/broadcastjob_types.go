@@ -0,0 +1,285 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	v1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// BroadcastJobSpec defines the desired state of BroadcastJob
+type BroadcastJobSpec struct {
+	// Parallelism specifies the maximum desired number of pods the job should
+	// run at any given time. The actual number of pods running in steady state will
+	// be less than this number when the work left to do is less than max parallelism.
+	// Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).
+	// Not setting this value means no limit.
+	// +optional
+	Parallelism *intstr.IntOrString `json:"parallelism,omitempty"`
+
+	// Template describes the pod that will be created when executing a job.
+	Template v1.PodTemplateSpec `json:"template"`
+
+	// CompletionPolicy indicates the completion policy of the job.
+	// Default is Always CompletionPolicyType
+	// +optional
+	CompletionPolicy CompletionPolicy `json:"completionPolicy"`
+
+	// Paused will pause the job, which means no more pods will be created, and the job can be resumed
+	// by setting it to false. The running pods are not affected.
+	// +optional
+	Paused bool `json:"paused,omitempty"`
+
+	// FailurePolicy indicates the behavior of the job, when failed pod is found.
+	// +optional
+	FailurePolicy FailurePolicy `json:"failurePolicy,omitempty"`
+
+	// PodActiveDeadlineSeconds specifies the duration in seconds relative to the startTime of each pod that
+	// the pod may be active before it is terminated and considered failed with PodDeadlineExceeded reason;
+	// value must be positive integer.
+	// +optional
+	PodActiveDeadlineSeconds *int64 `json:"podActiveDeadlineSeconds,omitempty"`
+
+	// NodeSelection explicitly selects the nodes to run pods, in addition to the node selector and affinity
+	// of pod template. A node is selected only if it matches all of the specified rules.
+	// +optional
+	NodeSelection *BroadcastJobNodeSelection `json:"nodeSelection,omitempty"`
+}
+
+// CompletionPolicy indicates the completion policy for the job
+type CompletionPolicy struct {
+	// Type indicates the type of the CompletionPolicy
+	// Default is Always
+	Type CompletionPolicyType `json:"type,omitempty"`
+
+	// ActiveDeadlineSeconds specifies the duration in seconds relative to the startTime that the job may be active
+	// before the system tries to terminate it; value must be positive integer.
+	// Only works for Always type.
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+
+	// ttlSecondsAfterFinished limits the lifetime of a Job that has finished
+	// execution (either Complete or Failed). If this field is set,
+	// ttlSecondsAfterFinished after the Job finishes, it is eligible to be
+	// automatically deleted. If this field is unset, the Job won't be automatically deleted.
+	// If this field is set to zero, the Job becomes eligible to be deleted immediately after it finishes.
+	// Only works for Always type
+	// +optional
+	TTLSecondsAfterFinished *int32 `json:"ttlSecondsAfterFinished,omitempty"`
+}
+
+// CompletionPolicyType indicates the type of completion policy
+type CompletionPolicyType string
+
+const (
+	// Always means the job will eventually finish on these conditions:
+	// 1) after all pods on the desired nodes are completed (regardless succeeded or failed),
+	// 2) exceeds ActiveDeadlineSeconds,
+	// 3) exceeds BackoffLimit.
+	// This is the default CompletionPolicyType
+	Always CompletionPolicyType = "Always"
+
+	// Never means the job will be kept alive after all pods on the desired nodes are completed.
+	// This is useful when new nodes are added after the job completes, the pods will be triggered automatically on those new nodes.
+	Never CompletionPolicyType = "Never"
+)
+
+// FailurePolicy indicates the behavior of the job, when failed pod is found.
+type FailurePolicy struct {
+	// Type indicates the type of FailurePolicyType.
+	Type FailurePolicyType `json:"type,omitempty"`
+
+	// RestartLimit specifies the number of retries before marking the pod failed.
+	RestartLimit int32 `json:"restartLimit,omitempty"`
+}
+
+// FailurePolicyType indicates the type of FailurePolicyType.
+type FailurePolicyType string
+
+const (
+	// FailurePolicyTypeContinue means the job will be still running, when failed pod is found.
+	FailurePolicyTypeContinue FailurePolicyType = "Continue"
+
+	// FailurePolicyTypeFailFast means the job will be failed, when failed pod is found.
+	FailurePolicyTypeFailFast FailurePolicyType = "FailFast"
+
+	// FailurePolicyTypePause means the the job will be paused, when failed pod is found.
+	FailurePolicyTypePause FailurePolicyType = "Pause"
+)
+
+// BroadcastJobNodeSelection defines the nodes that the job should run pods on.
+type BroadcastJobNodeSelection struct {
+	// LabelSelector is a label query over nodes.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+	// Names of the nodes to run pods on. If specified, only the listed nodes are selected.
+	// +optional
+	Names []string `json:"names,omitempty"`
+	// ExcludeNames are the names of nodes that should not run pods, which takes precedence over the other rules.
+	// +optional
+	ExcludeNames []string `json:"excludeNames,omitempty"`
+}
+
+// BroadcastJobStatus defines the observed state of BroadcastJob
+type BroadcastJobStatus struct {
+	// ObservedGeneration is the most recent generation observed for this BroadcastJob. It corresponds to the
+	// BroadcastJob's generation, which is updated on mutation by the API Server.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Phase of the job, one of Running, Paused, Completed and Failed.
+	// +optional
+	Phase BroadcastJobPhase `json:"phase,omitempty"`
+
+	// The latest available observations of an object's current state.
+	// +optional
+	// +patchMergeKey=type
+	// +patchStrategy=merge
+	Conditions []BroadcastJobCondition `json:"conditions,omitempty" patchStrategy:"merge" patchMergeKey:"type"`
+
+	// Represents time when the job was acknowledged by the job controller.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// Represents time when the job was completed or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// The desired number of pods, this is typically equal to the number of nodes satisfied to run pods.
+	// +optional
+	Desired int32 `json:"desired,omitempty"`
+
+	// The number of actively running pods.
+	// +optional
+	Active int32 `json:"active,omitempty"`
+
+	// The number of pods which reached phase Succeeded.
+	// +optional
+	Succeeded int32 `json:"succeeded,omitempty"`
+
+	// The number of pods which reached phase Failed.
+	// +optional
+	Failed int32 `json:"failed,omitempty"`
+
+	// NodeStatuses records the results of pods on the desired nodes. To bound the size of status,
+	// the nodes whose pods have succeeded are not recorded.
+	// +optional
+	NodeStatuses []BroadcastJobNodeStatus `json:"nodeStatuses,omitempty"`
+}
+
+// BroadcastJobPhase indicates the phase of the job.
+type BroadcastJobPhase string
+
+const (
+	// BroadcastJobPhaseRunning means the job is running.
+	BroadcastJobPhaseRunning BroadcastJobPhase = "Running"
+
+	// BroadcastJobPhasePaused means the job is paused.
+	BroadcastJobPhasePaused BroadcastJobPhase = "Paused"
+
+	// BroadcastJobPhaseCompleted means the job is completed.
+	BroadcastJobPhaseCompleted BroadcastJobPhase = "Completed"
+
+	// BroadcastJobPhaseFailed means the job is failed.
+	BroadcastJobPhaseFailed BroadcastJobPhase = "Failed"
+)
+
+// BroadcastJobNodeStatus records the result of the pod on a node.
+type BroadcastJobNodeStatus struct {
+	// NodeName is the name of the node.
+	NodeName string `json:"nodeName"`
+	// Phase is the phase of the pod on the node.
+	Phase v1.PodPhase `json:"phase"`
+	// RestartCount is the total restart count of the containers in the pod.
+	// +optional
+	RestartCount int32 `json:"restartCount,omitempty"`
+	// Message indicating details about why the pod is in this phase, such as PodDeadlineExceeded.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// BroadcastJobConditionType indicates valid conditions type of a BroadcastJob.
+type BroadcastJobConditionType string
+
+// These are valid conditions of a BroadcastJob.
+const (
+	// BroadcastJobConditionComplete means the job has completed its execution, which means pods have been
+	// deployed on all eligible nodes and all pods have reached succeeded or failed state.
+	BroadcastJobConditionComplete BroadcastJobConditionType = "Complete"
+
+	// BroadcastJobConditionFailed means the job has failed its execution, e.g. it has exceeded
+	// the ActiveDeadlineSeconds limit.
+	BroadcastJobConditionFailed BroadcastJobConditionType = "Failed"
+
+	// BroadcastJobConditionPaused means the job is paused by spec.paused, or by failurePolicy with Pause type.
+	BroadcastJobConditionPaused BroadcastJobConditionType = "Paused"
+)
+
+// BroadcastJobCondition describes current state of a BroadcastJob.
+type BroadcastJobCondition struct {
+	// Type of job condition.
+	Type BroadcastJobConditionType `json:"type"`
+	// Status of the condition, one of True, False, Unknown.
+	Status v1.ConditionStatus `json:"status"`
+	// Last time the condition was checked.
+	// +optional
+	LastProbeTime metav1.Time `json:"lastProbeTime,omitempty"`
+	// Last time the condition transit from one status to another.
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	// (brief) reason for the condition's last transition.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	// Human readable message indicating details about last transition.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +genclient
+// +k8s:openapi-gen=true
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:shortName=bcj
+// +kubebuilder:printcolumn:name="Desired",type="integer",JSONPath=".status.desired",description="The desired number of pods. This is typically equal to the number of nodes satisfied to run pods."
+// +kubebuilder:printcolumn:name="Active",type="integer",JSONPath=".status.active",description="The number of actively running pods."
+// +kubebuilder:printcolumn:name="Succeeded",type="integer",JSONPath=".status.succeeded",description="The number of pods which reached phase Succeeded."
+// +kubebuilder:printcolumn:name="Failed",type="integer",JSONPath=".status.failed",description="The number of pods which reached phase Failed."
+// +kubebuilder:printcolumn:name="Phase",type="string",JSONPath=".status.phase",description="The phase of the job."
+// +kubebuilder:printcolumn:name="AGE",type="date",JSONPath=".metadata.creationTimestamp",description="CreationTimestamp is a timestamp representing the server time when this object was created. It is not guaranteed to be set in happens-before order across separate operations. Clients may not set this value. It is represented in RFC3339 form and is in UTC."
+
+// BroadcastJob is the Schema for the broadcastjobs API
+type BroadcastJob struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   BroadcastJobSpec   `json:"spec,omitempty"`
+	Status BroadcastJobStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// BroadcastJobList contains a list of BroadcastJob
+type BroadcastJobList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []BroadcastJob `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&BroadcastJob{}, &BroadcastJobList{})
+}
@@ -0,0 +1,266 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"github.com/openkruise/kruise-api/apps/v1alpha1"
+	"k8s.io/apimachinery/pkg/conversion"
+)
+
+// CloneSet in v1beta1 has the same fields as v1alpha1 except for the renames below, so the conversion in both
+// directions is lossless:
+//   - updateStrategy.type is renamed to updateStrategy.podUpdatePolicy, with the PodUpdateStrategyType shared with
+//     StatefulSet, since it chooses how pods are updated rather than the type of the update strategy.
+//   - status.volumeClaims uses the VolumeClaimStatus shared with StatefulSet instead of CloneSetVolumeClaimStatus.
+// Like the StatefulSet ones, these functions share the memory of pointers, slices and maps between in and out
+// where the element types are the same.
+
+// Convert_v1alpha1_CloneSet_To_v1beta1_CloneSet converts v1alpha1 CloneSet to v1beta1.
+func Convert_v1alpha1_CloneSet_To_v1beta1_CloneSet(in *v1alpha1.CloneSet, out *CloneSet, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	out.TypeMeta.APIVersion = SchemeGroupVersion.String()
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_CloneSetSpec_To_v1beta1_CloneSetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return Convert_v1alpha1_CloneSetStatus_To_v1beta1_CloneSetStatus(&in.Status, &out.Status, s)
+}
+
+// Convert_v1beta1_CloneSet_To_v1alpha1_CloneSet converts v1beta1 CloneSet to v1alpha1.
+func Convert_v1beta1_CloneSet_To_v1alpha1_CloneSet(in *CloneSet, out *v1alpha1.CloneSet, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	out.TypeMeta.APIVersion = v1alpha1.SchemeGroupVersion.String()
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_CloneSetSpec_To_v1alpha1_CloneSetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	return Convert_v1beta1_CloneSetStatus_To_v1alpha1_CloneSetStatus(&in.Status, &out.Status, s)
+}
+
+// Convert_v1alpha1_CloneSetList_To_v1beta1_CloneSetList converts v1alpha1 CloneSetList to v1beta1.
+func Convert_v1alpha1_CloneSetList_To_v1beta1_CloneSetList(in *v1alpha1.CloneSetList, out *CloneSetList, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	out.TypeMeta.APIVersion = SchemeGroupVersion.String()
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]CloneSet, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1alpha1_CloneSet_To_v1beta1_CloneSet(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert_v1beta1_CloneSetList_To_v1alpha1_CloneSetList converts v1beta1 CloneSetList to v1alpha1.
+func Convert_v1beta1_CloneSetList_To_v1alpha1_CloneSetList(in *CloneSetList, out *v1alpha1.CloneSetList, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	out.TypeMeta.APIVersion = v1alpha1.SchemeGroupVersion.String()
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]v1alpha1.CloneSet, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_CloneSet_To_v1alpha1_CloneSet(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert_v1alpha1_CloneSetSpec_To_v1beta1_CloneSetSpec converts v1alpha1 CloneSetSpec to v1beta1.
+func Convert_v1alpha1_CloneSetSpec_To_v1beta1_CloneSetSpec(in *v1alpha1.CloneSetSpec, out *CloneSetSpec, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	out.Selector = in.Selector
+	out.Template = in.Template
+	out.VolumeClaimTemplates = in.VolumeClaimTemplates
+	out.ScaleStrategy = CloneSetScaleStrategy{
+		PodsToDelete:    in.ScaleStrategy.PodsToDelete,
+		MaxUnavailable:  in.ScaleStrategy.MaxUnavailable,
+		DisablePVCReuse: in.ScaleStrategy.DisablePVCReuse,
+	}
+	if err := Convert_v1alpha1_CloneSetUpdateStrategy_To_v1beta1_CloneSetUpdateStrategy(&in.UpdateStrategy, &out.UpdateStrategy, s); err != nil {
+		return err
+	}
+	out.RevisionHistoryLimit = in.RevisionHistoryLimit
+	out.MinReadySeconds = in.MinReadySeconds
+	out.Lifecycle = in.Lifecycle
+	out.VolumeClaimUpdateStrategy.Type = CloneSetVolumeClaimUpdateStrategyType(in.VolumeClaimUpdateStrategy.Type)
+	return nil
+}
+
+// Convert_v1beta1_CloneSetSpec_To_v1alpha1_CloneSetSpec converts v1beta1 CloneSetSpec to v1alpha1.
+func Convert_v1beta1_CloneSetSpec_To_v1alpha1_CloneSetSpec(in *CloneSetSpec, out *v1alpha1.CloneSetSpec, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	out.Selector = in.Selector
+	out.Template = in.Template
+	out.VolumeClaimTemplates = in.VolumeClaimTemplates
+	out.ScaleStrategy = v1alpha1.CloneSetScaleStrategy{
+		PodsToDelete:    in.ScaleStrategy.PodsToDelete,
+		MaxUnavailable:  in.ScaleStrategy.MaxUnavailable,
+		DisablePVCReuse: in.ScaleStrategy.DisablePVCReuse,
+	}
+	if err := Convert_v1beta1_CloneSetUpdateStrategy_To_v1alpha1_CloneSetUpdateStrategy(&in.UpdateStrategy, &out.UpdateStrategy, s); err != nil {
+		return err
+	}
+	out.RevisionHistoryLimit = in.RevisionHistoryLimit
+	out.MinReadySeconds = in.MinReadySeconds
+	out.Lifecycle = in.Lifecycle
+	out.VolumeClaimUpdateStrategy.Type = v1alpha1.CloneSetVolumeClaimUpdateStrategyType(in.VolumeClaimUpdateStrategy.Type)
+	return nil
+}
+
+// Convert_v1alpha1_CloneSetUpdateStrategy_To_v1beta1_CloneSetUpdateStrategy converts v1alpha1 CloneSetUpdateStrategy to v1beta1.
+func Convert_v1alpha1_CloneSetUpdateStrategy_To_v1beta1_CloneSetUpdateStrategy(in *v1alpha1.CloneSetUpdateStrategy, out *CloneSetUpdateStrategy, s conversion.Scope) error {
+	out.PodUpdatePolicy = PodUpdateStrategyType(in.Type)
+	out.Partition = in.Partition
+	out.PartitionSelector = in.PartitionSelector
+	out.MaxUnavailable = in.MaxUnavailable
+	out.MaxSurge = in.MaxSurge
+	out.Paused = in.Paused
+	out.PriorityStrategy = in.PriorityStrategy
+	if in.ScatterStrategy != nil {
+		out.ScatterStrategy = make(UpdateScatterStrategy, len(in.ScatterStrategy))
+		for i, term := range in.ScatterStrategy {
+			out.ScatterStrategy[i] = UpdateScatterTerm{Key: term.Key, Value: term.Value}
+		}
+	} else {
+		out.ScatterStrategy = nil
+	}
+	out.InPlaceUpdateStrategy = in.InPlaceUpdateStrategy
+	out.RecreatePolicy = CloneSetRecreatePolicyType(in.RecreatePolicy)
+	return nil
+}
+
+// Convert_v1beta1_CloneSetUpdateStrategy_To_v1alpha1_CloneSetUpdateStrategy converts v1beta1 CloneSetUpdateStrategy to v1alpha1.
+func Convert_v1beta1_CloneSetUpdateStrategy_To_v1alpha1_CloneSetUpdateStrategy(in *CloneSetUpdateStrategy, out *v1alpha1.CloneSetUpdateStrategy, s conversion.Scope) error {
+	out.Type = v1alpha1.CloneSetUpdateStrategyType(in.PodUpdatePolicy)
+	out.Partition = in.Partition
+	out.PartitionSelector = in.PartitionSelector
+	out.MaxUnavailable = in.MaxUnavailable
+	out.MaxSurge = in.MaxSurge
+	out.Paused = in.Paused
+	out.PriorityStrategy = in.PriorityStrategy
+	if in.ScatterStrategy != nil {
+		out.ScatterStrategy = make(v1alpha1.UpdateScatterStrategy, len(in.ScatterStrategy))
+		for i, term := range in.ScatterStrategy {
+			out.ScatterStrategy[i] = v1alpha1.UpdateScatterTerm{Key: term.Key, Value: term.Value}
+		}
+	} else {
+		out.ScatterStrategy = nil
+	}
+	out.InPlaceUpdateStrategy = in.InPlaceUpdateStrategy
+	out.RecreatePolicy = v1alpha1.CloneSetRecreatePolicyType(in.RecreatePolicy)
+	return nil
+}
+
+// Convert_v1alpha1_CloneSetStatus_To_v1beta1_CloneSetStatus converts v1alpha1 CloneSetStatus to v1beta1.
+func Convert_v1alpha1_CloneSetStatus_To_v1beta1_CloneSetStatus(in *v1alpha1.CloneSetStatus, out *CloneSetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.UpdatedReadyReplicas = in.UpdatedReadyReplicas
+	out.ExpectedUpdatedReplicas = in.ExpectedUpdatedReplicas
+	out.UpdateRevision = in.UpdateRevision
+	out.CurrentRevision = in.CurrentRevision
+	out.CollisionCount = in.CollisionCount
+	if in.Conditions != nil {
+		out.Conditions = make([]CloneSetCondition, len(in.Conditions))
+		for i, c := range in.Conditions {
+			out.Conditions[i] = CloneSetCondition{
+				Type:               CloneSetConditionType(c.Type),
+				Status:             c.Status,
+				LastTransitionTime: c.LastTransitionTime,
+				Reason:             c.Reason,
+				Message:            c.Message,
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.LabelSelector = in.LabelSelector
+	if in.VolumeClaims != nil {
+		out.VolumeClaims = make([]VolumeClaimStatus, len(in.VolumeClaims))
+		for i, c := range in.VolumeClaims {
+			out.VolumeClaims[i] = VolumeClaimStatus(c)
+		}
+	} else {
+		out.VolumeClaims = nil
+	}
+	if in.RevisionReplicas != nil {
+		out.RevisionReplicas = make([]CloneSetRevisionReplicas, len(in.RevisionReplicas))
+		for i, r := range in.RevisionReplicas {
+			out.RevisionReplicas[i] = CloneSetRevisionReplicas(r)
+		}
+	} else {
+		out.RevisionReplicas = nil
+	}
+	return nil
+}
+
+// Convert_v1beta1_CloneSetStatus_To_v1alpha1_CloneSetStatus converts v1beta1 CloneSetStatus to v1alpha1.
+func Convert_v1beta1_CloneSetStatus_To_v1alpha1_CloneSetStatus(in *CloneSetStatus, out *v1alpha1.CloneSetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.UpdatedReadyReplicas = in.UpdatedReadyReplicas
+	out.ExpectedUpdatedReplicas = in.ExpectedUpdatedReplicas
+	out.UpdateRevision = in.UpdateRevision
+	out.CurrentRevision = in.CurrentRevision
+	out.CollisionCount = in.CollisionCount
+	if in.Conditions != nil {
+		out.Conditions = make([]v1alpha1.CloneSetCondition, len(in.Conditions))
+		for i, c := range in.Conditions {
+			out.Conditions[i] = v1alpha1.CloneSetCondition{
+				Type:               v1alpha1.CloneSetConditionType(c.Type),
+				Status:             c.Status,
+				LastTransitionTime: c.LastTransitionTime,
+				Reason:             c.Reason,
+				Message:            c.Message,
+			}
+		}
+	} else {
+		out.Conditions = nil
+	}
+	out.LabelSelector = in.LabelSelector
+	if in.VolumeClaims != nil {
+		out.VolumeClaims = make([]v1alpha1.CloneSetVolumeClaimStatus, len(in.VolumeClaims))
+		for i, c := range in.VolumeClaims {
+			out.VolumeClaims[i] = v1alpha1.CloneSetVolumeClaimStatus(c)
+		}
+	} else {
+		out.VolumeClaims = nil
+	}
+	if in.RevisionReplicas != nil {
+		out.RevisionReplicas = make([]v1alpha1.CloneSetRevisionReplicas, len(in.RevisionReplicas))
+		for i, r := range in.RevisionReplicas {
+			out.RevisionReplicas[i] = v1alpha1.CloneSetRevisionReplicas(r)
+		}
+	} else {
+		out.RevisionReplicas = nil
+	}
+	return nil
+}
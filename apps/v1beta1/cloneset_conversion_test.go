@@ -0,0 +1,98 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/openkruise/kruise-api/apps/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+)
+
+func TestCloneSetConversionRoundTrip(t *testing.T) {
+	f := fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(1), serializer.NewCodecFactory(runtime.NewScheme()))
+	for i := 0; i < conversionFuzzIters; i++ {
+		original := &v1alpha1.CloneSet{}
+		f.Fuzz(original)
+		original.APIVersion = v1alpha1.SchemeGroupVersion.String()
+
+		converted := &CloneSet{}
+		if err := Convert_v1alpha1_CloneSet_To_v1beta1_CloneSet(original.DeepCopy(), converted, nil); err != nil {
+			t.Fatalf("failed to convert to v1beta1: %v", err)
+		}
+		if converted.APIVersion != SchemeGroupVersion.String() {
+			t.Fatalf("expected apiVersion %s, got %s", SchemeGroupVersion.String(), converted.APIVersion)
+		}
+		roundTripped := &v1alpha1.CloneSet{}
+		if err := Convert_v1beta1_CloneSet_To_v1alpha1_CloneSet(converted, roundTripped, nil); err != nil {
+			t.Fatalf("failed to convert back to v1alpha1: %v", err)
+		}
+		if !apiequality.Semantic.DeepEqual(original, roundTripped) {
+			t.Fatalf("expected CloneSet unchanged after round trip, diff: %s", diff.ObjectReflectDiff(original, roundTripped))
+		}
+	}
+}
+
+func TestCloneSetConversionRoundTripFromV1beta1(t *testing.T) {
+	f := fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(1), serializer.NewCodecFactory(runtime.NewScheme()))
+	for i := 0; i < conversionFuzzIters; i++ {
+		original := &CloneSet{}
+		f.Fuzz(original)
+		original.APIVersion = SchemeGroupVersion.String()
+
+		converted := &v1alpha1.CloneSet{}
+		if err := Convert_v1beta1_CloneSet_To_v1alpha1_CloneSet(original.DeepCopy(), converted, nil); err != nil {
+			t.Fatalf("failed to convert to v1alpha1: %v", err)
+		}
+		roundTripped := &CloneSet{}
+		if err := Convert_v1alpha1_CloneSet_To_v1beta1_CloneSet(converted, roundTripped, nil); err != nil {
+			t.Fatalf("failed to convert back to v1beta1: %v", err)
+		}
+		if !apiequality.Semantic.DeepEqual(original, roundTripped) {
+			t.Fatalf("expected CloneSet unchanged after round trip, diff: %s", diff.ObjectReflectDiff(original, roundTripped))
+		}
+	}
+}
+
+func TestCloneSetConversionRenamedFields(t *testing.T) {
+	in := &v1alpha1.CloneSet{
+		Spec: v1alpha1.CloneSetSpec{
+			UpdateStrategy: v1alpha1.CloneSetUpdateStrategy{Type: v1alpha1.InPlaceIfPossibleCloneSetUpdateStrategyType},
+		},
+		Status: v1alpha1.CloneSetStatus{
+			VolumeClaims: []v1alpha1.CloneSetVolumeClaimStatus{{VolumeClaimName: "data", Replicas: 3, CompatibleReplicas: 2}},
+		},
+	}
+
+	out := &CloneSet{}
+	if err := Convert_v1alpha1_CloneSet_To_v1beta1_CloneSet(in, out, nil); err != nil {
+		t.Fatalf("failed to convert to v1beta1: %v", err)
+	}
+	if out.Spec.UpdateStrategy.PodUpdatePolicy != InPlaceIfPossiblePodUpdateStrategyType {
+		t.Fatalf("expected podUpdatePolicy %s, got %s", InPlaceIfPossiblePodUpdateStrategyType, out.Spec.UpdateStrategy.PodUpdatePolicy)
+	}
+	expectedVolumeClaims := []VolumeClaimStatus{{VolumeClaimName: "data", Replicas: 3, CompatibleReplicas: 2}}
+	if !apiequality.Semantic.DeepEqual(out.Status.VolumeClaims, expectedVolumeClaims) {
+		t.Fatalf("expected volumeClaims %v, got %v", expectedVolumeClaims, out.Status.VolumeClaims)
+	}
+}
@@ -0,0 +1,327 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"encoding/json"
+	"fmt"
+
+	appspub "github.com/openkruise/kruise-api/apps/pub"
+	"github.com/openkruise/kruise-api/apps/v1alpha1"
+	"k8s.io/apimachinery/pkg/conversion"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+// StatefulSetV1beta1FieldsAnnotation is the annotation on a v1alpha1 StatefulSet that keeps the fields
+// only existing in v1beta1 as JSON, so that they can be restored when converting back to v1beta1.
+const StatefulSetV1beta1FieldsAnnotation = "apps.kruise.io/statefulset-v1beta1-fields"
+
+// Note that the conversion functions share the memory of pointers, slices and maps between in and out,
+// so callers should DeepCopy the input first if it will be modified afterwards.
+// The annotations map is the exception, it is copied before StatefulSetV1beta1FieldsAnnotation is set or removed.
+
+// statefulSetV1beta1Fields contains the StatefulSet fields that v1alpha1 can not hold.
+type statefulSetV1beta1Fields struct {
+	PartitionPercent                     *intstr.IntOrString                              `json:"partitionPercent,omitempty"`
+	MaxSurge                             *intstr.IntOrString                              `json:"maxSurge,omitempty"`
+	ReserveOrdinals                      []int                                            `json:"reserveOrdinals,omitempty"`
+	Lifecycle                            *appspub.Lifecycle                               `json:"lifecycle,omitempty"`
+	ScaleStrategy                        *StatefulSetScaleStrategy                        `json:"scaleStrategy,omitempty"`
+	PersistentVolumeClaimRetentionPolicy *StatefulSetPersistentVolumeClaimRetentionPolicy `json:"persistentVolumeClaimRetentionPolicy,omitempty"`
+	VolumeClaimUpdateStrategy            *VolumeClaimUpdateStrategy                       `json:"volumeClaimUpdateStrategy,omitempty"`
+	Ordinals                             *StatefulSetOrdinals                             `json:"ordinals,omitempty"`
+	MinReadySeconds                      *int32                                           `json:"minReadySeconds,omitempty"`
+	VolumeClaims                         []VolumeClaimStatus                              `json:"volumeClaims,omitempty"`
+}
+
+// saveStatefulSetV1beta1Fields stores the v1beta1-only fields of in into the annotations of out.
+func saveStatefulSetV1beta1Fields(in *StatefulSet, out *v1alpha1.StatefulSet) error {
+	fields := statefulSetV1beta1Fields{
+		ReserveOrdinals:                      in.Spec.ReserveOrdinals,
+		Lifecycle:                            in.Spec.Lifecycle,
+		ScaleStrategy:                        in.Spec.ScaleStrategy,
+		PersistentVolumeClaimRetentionPolicy: in.Spec.PersistentVolumeClaimRetentionPolicy,
+		Ordinals:                             in.Spec.Ordinals,
+		MinReadySeconds:                      in.Spec.MinReadySeconds,
+		VolumeClaims:                         in.Status.VolumeClaims,
+	}
+	if in.Spec.UpdateStrategy.RollingUpdate != nil {
+		fields.PartitionPercent = in.Spec.UpdateStrategy.RollingUpdate.PartitionPercent
+		fields.MaxSurge = in.Spec.UpdateStrategy.RollingUpdate.MaxSurge
+	}
+	if in.Spec.VolumeClaimUpdateStrategy != (VolumeClaimUpdateStrategy{}) {
+		fields.VolumeClaimUpdateStrategy = &in.Spec.VolumeClaimUpdateStrategy
+	}
+
+	data, err := json.Marshal(fields)
+	if err != nil {
+		return err
+	}
+	if string(data) == "{}" {
+		out.Annotations = withoutAnnotation(in.Annotations, StatefulSetV1beta1FieldsAnnotation)
+	} else {
+		out.Annotations = withAnnotation(in.Annotations, StatefulSetV1beta1FieldsAnnotation, string(data))
+	}
+	return nil
+}
+
+// restoreStatefulSetV1beta1Fields restores the v1beta1-only fields of out from the annotations of in,
+// and removes the annotation from out.
+func restoreStatefulSetV1beta1Fields(in *v1alpha1.StatefulSet, out *StatefulSet) error {
+	data, ok := in.Annotations[StatefulSetV1beta1FieldsAnnotation]
+	if !ok {
+		return nil
+	}
+	fields := statefulSetV1beta1Fields{}
+	if err := json.Unmarshal([]byte(data), &fields); err != nil {
+		return fmt.Errorf("failed to unmarshal annotation %s: %v", StatefulSetV1beta1FieldsAnnotation, err)
+	}
+
+	out.Annotations = withoutAnnotation(in.Annotations, StatefulSetV1beta1FieldsAnnotation)
+	out.Spec.ReserveOrdinals = fields.ReserveOrdinals
+	out.Spec.Lifecycle = fields.Lifecycle
+	out.Spec.ScaleStrategy = fields.ScaleStrategy
+	out.Spec.PersistentVolumeClaimRetentionPolicy = fields.PersistentVolumeClaimRetentionPolicy
+	out.Spec.Ordinals = fields.Ordinals
+	out.Spec.MinReadySeconds = fields.MinReadySeconds
+	out.Status.VolumeClaims = fields.VolumeClaims
+	if out.Spec.UpdateStrategy.RollingUpdate != nil {
+		out.Spec.UpdateStrategy.RollingUpdate.PartitionPercent = fields.PartitionPercent
+		out.Spec.UpdateStrategy.RollingUpdate.MaxSurge = fields.MaxSurge
+	}
+	if fields.VolumeClaimUpdateStrategy != nil {
+		out.Spec.VolumeClaimUpdateStrategy = *fields.VolumeClaimUpdateStrategy
+	}
+	return nil
+}
+
+// withAnnotation returns a copy of annotations with key set to value.
+func withAnnotation(annotations map[string]string, key, value string) map[string]string {
+	copied := make(map[string]string, len(annotations)+1)
+	for k, v := range annotations {
+		copied[k] = v
+	}
+	copied[key] = value
+	return copied
+}
+
+// withoutAnnotation returns a copy of annotations without key, or annotations itself if key does not exist.
+// The copy is nil if there is no other annotation.
+func withoutAnnotation(annotations map[string]string, key string) map[string]string {
+	if _, ok := annotations[key]; !ok {
+		return annotations
+	}
+	var copied map[string]string
+	for k, v := range annotations {
+		if k == key {
+			continue
+		}
+		if copied == nil {
+			copied = make(map[string]string, len(annotations)-1)
+		}
+		copied[k] = v
+	}
+	return copied
+}
+
+// Convert_v1alpha1_StatefulSet_To_v1beta1_StatefulSet converts v1alpha1 StatefulSet to v1beta1.
+// The fields only in v1beta1 are restored from StatefulSetV1beta1FieldsAnnotation if it exists.
+func Convert_v1alpha1_StatefulSet_To_v1beta1_StatefulSet(in *v1alpha1.StatefulSet, out *StatefulSet, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	out.TypeMeta.APIVersion = SchemeGroupVersion.String()
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1alpha1_StatefulSetSpec_To_v1beta1_StatefulSetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1alpha1_StatefulSetStatus_To_v1beta1_StatefulSetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return restoreStatefulSetV1beta1Fields(in, out)
+}
+
+// Convert_v1beta1_StatefulSet_To_v1alpha1_StatefulSet converts v1beta1 StatefulSet to v1alpha1.
+// The fields only in v1beta1 are kept in StatefulSetV1beta1FieldsAnnotation.
+func Convert_v1beta1_StatefulSet_To_v1alpha1_StatefulSet(in *StatefulSet, out *v1alpha1.StatefulSet, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	out.TypeMeta.APIVersion = v1alpha1.SchemeGroupVersion.String()
+	out.ObjectMeta = in.ObjectMeta
+	if err := Convert_v1beta1_StatefulSetSpec_To_v1alpha1_StatefulSetSpec(&in.Spec, &out.Spec, s); err != nil {
+		return err
+	}
+	if err := Convert_v1beta1_StatefulSetStatus_To_v1alpha1_StatefulSetStatus(&in.Status, &out.Status, s); err != nil {
+		return err
+	}
+	return saveStatefulSetV1beta1Fields(in, out)
+}
+
+// Convert_v1alpha1_StatefulSetList_To_v1beta1_StatefulSetList converts v1alpha1 StatefulSetList to v1beta1.
+func Convert_v1alpha1_StatefulSetList_To_v1beta1_StatefulSetList(in *v1alpha1.StatefulSetList, out *StatefulSetList, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	out.TypeMeta.APIVersion = SchemeGroupVersion.String()
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]StatefulSet, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1alpha1_StatefulSet_To_v1beta1_StatefulSet(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert_v1beta1_StatefulSetList_To_v1alpha1_StatefulSetList converts v1beta1 StatefulSetList to v1alpha1.
+func Convert_v1beta1_StatefulSetList_To_v1alpha1_StatefulSetList(in *StatefulSetList, out *v1alpha1.StatefulSetList, s conversion.Scope) error {
+	out.TypeMeta = in.TypeMeta
+	out.TypeMeta.APIVersion = v1alpha1.SchemeGroupVersion.String()
+	out.ListMeta = in.ListMeta
+	if in.Items == nil {
+		out.Items = nil
+		return nil
+	}
+	out.Items = make([]v1alpha1.StatefulSet, len(in.Items))
+	for i := range in.Items {
+		if err := Convert_v1beta1_StatefulSet_To_v1alpha1_StatefulSet(&in.Items[i], &out.Items[i], s); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Convert_v1alpha1_StatefulSetSpec_To_v1beta1_StatefulSetSpec converts v1alpha1 StatefulSetSpec to v1beta1.
+func Convert_v1alpha1_StatefulSetSpec_To_v1beta1_StatefulSetSpec(in *v1alpha1.StatefulSetSpec, out *StatefulSetSpec, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	out.Selector = in.Selector
+	out.Template = in.Template
+	out.VolumeClaimTemplates = in.VolumeClaimTemplates
+	out.ServiceName = in.ServiceName
+	out.PodManagementPolicy = in.PodManagementPolicy
+	if err := Convert_v1alpha1_StatefulSetUpdateStrategy_To_v1beta1_StatefulSetUpdateStrategy(&in.UpdateStrategy, &out.UpdateStrategy, s); err != nil {
+		return err
+	}
+	out.RevisionHistoryLimit = in.RevisionHistoryLimit
+	return nil
+}
+
+// Convert_v1beta1_StatefulSetSpec_To_v1alpha1_StatefulSetSpec converts v1beta1 StatefulSetSpec to v1alpha1.
+// Fields only in v1beta1 are not converted here, Convert_v1beta1_StatefulSet_To_v1alpha1_StatefulSet keeps them
+// in StatefulSetV1beta1FieldsAnnotation.
+func Convert_v1beta1_StatefulSetSpec_To_v1alpha1_StatefulSetSpec(in *StatefulSetSpec, out *v1alpha1.StatefulSetSpec, s conversion.Scope) error {
+	out.Replicas = in.Replicas
+	out.Selector = in.Selector
+	out.Template = in.Template
+	out.VolumeClaimTemplates = in.VolumeClaimTemplates
+	out.ServiceName = in.ServiceName
+	out.PodManagementPolicy = in.PodManagementPolicy
+	if err := Convert_v1beta1_StatefulSetUpdateStrategy_To_v1alpha1_StatefulSetUpdateStrategy(&in.UpdateStrategy, &out.UpdateStrategy, s); err != nil {
+		return err
+	}
+	out.RevisionHistoryLimit = in.RevisionHistoryLimit
+	return nil
+}
+
+// Convert_v1alpha1_StatefulSetUpdateStrategy_To_v1beta1_StatefulSetUpdateStrategy converts v1alpha1 StatefulSetUpdateStrategy to v1beta1.
+func Convert_v1alpha1_StatefulSetUpdateStrategy_To_v1beta1_StatefulSetUpdateStrategy(in *v1alpha1.StatefulSetUpdateStrategy, out *StatefulSetUpdateStrategy, s conversion.Scope) error {
+	out.Type = in.Type
+	if in.RollingUpdate == nil {
+		out.RollingUpdate = nil
+		return nil
+	}
+	out.RollingUpdate = new(RollingUpdateStatefulSetStrategy)
+	return Convert_v1alpha1_RollingUpdateStatefulSetStrategy_To_v1beta1_RollingUpdateStatefulSetStrategy(in.RollingUpdate, out.RollingUpdate, s)
+}
+
+// Convert_v1beta1_StatefulSetUpdateStrategy_To_v1alpha1_StatefulSetUpdateStrategy converts v1beta1 StatefulSetUpdateStrategy to v1alpha1.
+func Convert_v1beta1_StatefulSetUpdateStrategy_To_v1alpha1_StatefulSetUpdateStrategy(in *StatefulSetUpdateStrategy, out *v1alpha1.StatefulSetUpdateStrategy, s conversion.Scope) error {
+	out.Type = in.Type
+	if in.RollingUpdate == nil {
+		out.RollingUpdate = nil
+		return nil
+	}
+	out.RollingUpdate = new(v1alpha1.RollingUpdateStatefulSetStrategy)
+	return Convert_v1beta1_RollingUpdateStatefulSetStrategy_To_v1alpha1_RollingUpdateStatefulSetStrategy(in.RollingUpdate, out.RollingUpdate, s)
+}
+
+// Convert_v1alpha1_RollingUpdateStatefulSetStrategy_To_v1beta1_RollingUpdateStatefulSetStrategy converts v1alpha1 RollingUpdateStatefulSetStrategy to v1beta1.
+func Convert_v1alpha1_RollingUpdateStatefulSetStrategy_To_v1beta1_RollingUpdateStatefulSetStrategy(in *v1alpha1.RollingUpdateStatefulSetStrategy, out *RollingUpdateStatefulSetStrategy, s conversion.Scope) error {
+	out.Partition = in.Partition
+	out.MaxUnavailable = in.MaxUnavailable
+	out.PodUpdatePolicy = PodUpdateStrategyType(in.PodUpdatePolicy)
+	out.Paused = in.Paused
+	if in.UnorderedUpdate != nil {
+		out.UnorderedUpdate = &UnorderedUpdateStrategy{PriorityStrategy: in.UnorderedUpdate.PriorityStrategy}
+	} else {
+		out.UnorderedUpdate = nil
+	}
+	out.InPlaceUpdateStrategy = in.InPlaceUpdateStrategy
+	out.MinReadySeconds = in.MinReadySeconds
+	return nil
+}
+
+// Convert_v1beta1_RollingUpdateStatefulSetStrategy_To_v1alpha1_RollingUpdateStatefulSetStrategy converts v1beta1 RollingUpdateStatefulSetStrategy to v1alpha1.
+func Convert_v1beta1_RollingUpdateStatefulSetStrategy_To_v1alpha1_RollingUpdateStatefulSetStrategy(in *RollingUpdateStatefulSetStrategy, out *v1alpha1.RollingUpdateStatefulSetStrategy, s conversion.Scope) error {
+	out.Partition = in.Partition
+	out.MaxUnavailable = in.MaxUnavailable
+	out.PodUpdatePolicy = v1alpha1.PodUpdateStrategyType(in.PodUpdatePolicy)
+	out.Paused = in.Paused
+	if in.UnorderedUpdate != nil {
+		out.UnorderedUpdate = &v1alpha1.UnorderedUpdateStrategy{PriorityStrategy: in.UnorderedUpdate.PriorityStrategy}
+	} else {
+		out.UnorderedUpdate = nil
+	}
+	out.InPlaceUpdateStrategy = in.InPlaceUpdateStrategy
+	out.MinReadySeconds = in.MinReadySeconds
+	return nil
+}
+
+// Convert_v1alpha1_StatefulSetStatus_To_v1beta1_StatefulSetStatus converts v1alpha1 StatefulSetStatus to v1beta1.
+func Convert_v1alpha1_StatefulSetStatus_To_v1beta1_StatefulSetStatus(in *v1alpha1.StatefulSetStatus, out *StatefulSetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.CurrentReplicas = in.CurrentReplicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.UpdatedReadyReplicas = in.UpdatedReadyReplicas
+	out.UpdatedAvailableReplicas = in.UpdatedAvailableReplicas
+	out.CurrentRevision = in.CurrentRevision
+	out.UpdateRevision = in.UpdateRevision
+	out.CollisionCount = in.CollisionCount
+	out.Conditions = in.Conditions
+	out.LabelSelector = in.LabelSelector
+	return nil
+}
+
+// Convert_v1beta1_StatefulSetStatus_To_v1alpha1_StatefulSetStatus converts v1beta1 StatefulSetStatus to v1alpha1.
+func Convert_v1beta1_StatefulSetStatus_To_v1alpha1_StatefulSetStatus(in *StatefulSetStatus, out *v1alpha1.StatefulSetStatus, s conversion.Scope) error {
+	out.ObservedGeneration = in.ObservedGeneration
+	out.Replicas = in.Replicas
+	out.ReadyReplicas = in.ReadyReplicas
+	out.AvailableReplicas = in.AvailableReplicas
+	out.CurrentReplicas = in.CurrentReplicas
+	out.UpdatedReplicas = in.UpdatedReplicas
+	out.UpdatedReadyReplicas = in.UpdatedReadyReplicas
+	out.UpdatedAvailableReplicas = in.UpdatedAvailableReplicas
+	out.CurrentRevision = in.CurrentRevision
+	out.UpdateRevision = in.UpdateRevision
+	out.CollisionCount = in.CollisionCount
+	out.Conditions = in.Conditions
+	out.LabelSelector = in.LabelSelector
+	return nil
+}
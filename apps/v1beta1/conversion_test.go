@@ -0,0 +1,104 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/openkruise/kruise-api/apps/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/serializer"
+	"k8s.io/apimachinery/pkg/util/diff"
+)
+
+// conversionFuzzIters is the number of random objects to round trip in each conversion test.
+const conversionFuzzIters = 200
+
+func TestStatefulSetConversionRoundTrip(t *testing.T) {
+	f := fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(1), serializer.NewCodecFactory(runtime.NewScheme()))
+	for i := 0; i < conversionFuzzIters; i++ {
+		original := &v1alpha1.StatefulSet{}
+		f.Fuzz(original)
+		original.APIVersion = v1alpha1.SchemeGroupVersion.String()
+
+		converted := &StatefulSet{}
+		if err := Convert_v1alpha1_StatefulSet_To_v1beta1_StatefulSet(original.DeepCopy(), converted, nil); err != nil {
+			t.Fatalf("failed to convert to v1beta1: %v", err)
+		}
+		if converted.APIVersion != SchemeGroupVersion.String() {
+			t.Fatalf("expected apiVersion %s, got %s", SchemeGroupVersion.String(), converted.APIVersion)
+		}
+		roundTripped := &v1alpha1.StatefulSet{}
+		if err := Convert_v1beta1_StatefulSet_To_v1alpha1_StatefulSet(converted, roundTripped, nil); err != nil {
+			t.Fatalf("failed to convert back to v1alpha1: %v", err)
+		}
+		if !apiequality.Semantic.DeepEqual(original, roundTripped) {
+			t.Fatalf("expected StatefulSet unchanged after round trip, diff: %s", diff.ObjectReflectDiff(original, roundTripped))
+		}
+	}
+}
+
+func TestStatefulSetConversionRoundTripFromV1beta1(t *testing.T) {
+	f := fuzzer.FuzzerFor(metafuzzer.Funcs, rand.NewSource(1), serializer.NewCodecFactory(runtime.NewScheme()))
+	for i := 0; i < conversionFuzzIters; i++ {
+		original := &StatefulSet{}
+		f.Fuzz(original)
+		original.APIVersion = SchemeGroupVersion.String()
+
+		converted := &v1alpha1.StatefulSet{}
+		if err := Convert_v1beta1_StatefulSet_To_v1alpha1_StatefulSet(original.DeepCopy(), converted, nil); err != nil {
+			t.Fatalf("failed to convert to v1alpha1: %v", err)
+		}
+		if converted.APIVersion != v1alpha1.SchemeGroupVersion.String() {
+			t.Fatalf("expected apiVersion %s, got %s", v1alpha1.SchemeGroupVersion.String(), converted.APIVersion)
+		}
+		roundTripped := &StatefulSet{}
+		if err := Convert_v1alpha1_StatefulSet_To_v1beta1_StatefulSet(converted, roundTripped, nil); err != nil {
+			t.Fatalf("failed to convert back to v1beta1: %v", err)
+		}
+		if !apiequality.Semantic.DeepEqual(original, roundTripped) {
+			t.Fatalf("expected StatefulSet unchanged after round trip, diff: %s", diff.ObjectReflectDiff(original, roundTripped))
+		}
+	}
+}
+
+func TestStatefulSetConversionKeepsInputAnnotations(t *testing.T) {
+	minReadySeconds := int32(10)
+	in := &StatefulSet{}
+	in.Annotations = map[string]string{"foo": "bar"}
+	in.Spec.MinReadySeconds = &minReadySeconds
+
+	out := &v1alpha1.StatefulSet{}
+	if err := Convert_v1beta1_StatefulSet_To_v1alpha1_StatefulSet(in, out, nil); err != nil {
+		t.Fatalf("failed to convert to v1alpha1: %v", err)
+	}
+	if _, ok := out.Annotations[StatefulSetV1beta1FieldsAnnotation]; !ok {
+		t.Fatalf("expected annotation %s on v1alpha1 StatefulSet", StatefulSetV1beta1FieldsAnnotation)
+	}
+	if len(in.Annotations) != 1 {
+		t.Fatalf("expected input annotations unchanged, got %v", in.Annotations)
+	}
+
+	out.Annotations[StatefulSetV1beta1FieldsAnnotation] = "{"
+	if err := Convert_v1alpha1_StatefulSet_To_v1beta1_StatefulSet(out, &StatefulSet{}, nil); err == nil {
+		t.Fatalf("expected error for invalid annotation, got nil")
+	}
+}
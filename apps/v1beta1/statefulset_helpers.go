@@ -0,0 +1,118 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// GetReserveOrdinalIntSet returns the non-negative ordinals in ReserveOrdinals as a set.
+func (s *StatefulSetSpec) GetReserveOrdinalIntSet() sets.Int {
+	reserveOrdinals := sets.NewInt()
+	for _, o := range s.ReserveOrdinals {
+		if o >= 0 {
+			reserveOrdinals.Insert(o)
+		}
+	}
+	return reserveOrdinals
+}
+
+// GetStartOrdinal returns the ordinal of the first replica, which defaults to 0.
+func (s *StatefulSetSpec) GetStartOrdinal() int {
+	if s.Ordinals == nil {
+		return 0
+	}
+	return int(s.Ordinals.Start)
+}
+
+// GetEffectiveOrdinals returns the ordinals of Pods that are expected to be running, in ascending order.
+// Ordinals start from ordinals.start, and reserved ordinals are skipped, so there will always be
+// replicas number of ordinals.
+// For example, with replicas=3 and reserveOrdinals=[1], the effective ordinals are [0, 2, 3].
+func (s *StatefulSetSpec) GetEffectiveOrdinals() []int {
+	replicas := 1
+	if s.Replicas != nil {
+		replicas = int(*s.Replicas)
+	}
+	if replicas <= 0 {
+		return nil
+	}
+
+	reserveOrdinals := s.GetReserveOrdinalIntSet()
+	ordinals := make([]int, 0, replicas)
+	for o := s.GetStartOrdinal(); len(ordinals) < replicas; o++ {
+		if !reserveOrdinals.Has(o) {
+			ordinals = append(ordinals, o)
+		}
+	}
+	return ordinals
+}
+
+// GetPersistentVolumeClaimRetentionPolicy returns the PersistentVolumeClaimRetentionPolicy,
+// in which unset policies default to Retain.
+func (s *StatefulSetSpec) GetPersistentVolumeClaimRetentionPolicy() StatefulSetPersistentVolumeClaimRetentionPolicy {
+	policy := StatefulSetPersistentVolumeClaimRetentionPolicy{
+		WhenDeleted: RetainPersistentVolumeClaimRetentionPolicyType,
+		WhenScaled:  RetainPersistentVolumeClaimRetentionPolicyType,
+	}
+	if s.PersistentVolumeClaimRetentionPolicy != nil {
+		if s.PersistentVolumeClaimRetentionPolicy.WhenDeleted != "" {
+			policy.WhenDeleted = s.PersistentVolumeClaimRetentionPolicy.WhenDeleted
+		}
+		if s.PersistentVolumeClaimRetentionPolicy.WhenScaled != "" {
+			policy.WhenScaled = s.PersistentVolumeClaimRetentionPolicy.WhenScaled
+		}
+	}
+	return policy
+}
+
+// ResolvePartition returns the absolute partition against the given replicas.
+// PartitionPercent is calculated by rounding up, and the result will not exceed replicas.
+func (r *RollingUpdateStatefulSetStrategy) ResolvePartition(replicas int32) (int32, error) {
+	if r == nil {
+		return 0, nil
+	}
+
+	var partition int32
+	if r.PartitionPercent != nil {
+		p, err := intstr.GetValueFromIntOrPercent(r.PartitionPercent, int(replicas), true)
+		if err != nil {
+			return 0, err
+		}
+		partition = int32(p)
+	} else if r.Partition != nil {
+		partition = *r.Partition
+	}
+
+	if partition > replicas {
+		partition = replicas
+	}
+	return partition, nil
+}
+
+// GetRollingUpdateMinReadySeconds returns the minReadySeconds used during rolling update, which is resolved
+// in the order of rollingUpdate.minReadySeconds, spec.minReadySeconds and 0.
+func (s *StatefulSetSpec) GetRollingUpdateMinReadySeconds() int32 {
+	if s.UpdateStrategy.RollingUpdate != nil && s.UpdateStrategy.RollingUpdate.MinReadySeconds != nil {
+		return *s.UpdateStrategy.RollingUpdate.MinReadySeconds
+	}
+	if s.MinReadySeconds != nil {
+		return *s.MinReadySeconds
+	}
+	return 0
+}
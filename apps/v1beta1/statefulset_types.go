@@ -51,6 +51,11 @@ type RollingUpdateStatefulSetStrategy struct {
 	// Default value is 0.
 	// +optional
 	Partition *int32 `json:"partition,omitempty"`
+	// PartitionPercent is the partition that can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%),
+	// which is useful for canary by percentage. It has the same meaning as Partition and can not be set together with it.
+	// Absolute number is calculated from percentage by rounding up.
+	// +optional
+	PartitionPercent *intstr.IntOrString `json:"partitionPercent,omitempty"`
 	// The maximum number of pods that can be unavailable during the update.
 	// Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).
 	// Absolute number is calculated from percentage by rounding down.
@@ -58,6 +63,13 @@ type RollingUpdateStatefulSetStrategy struct {
 	// Defaults to 1.
 	// +optional
 	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+	// The maximum number of pods that can be scheduled above the desired replicas during update.
+	// Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).
+	// Absolute number is calculated from percentage by rounding up.
+	// Also, maxSurge can just be allowed to work with Parallel podManagementPolicy.
+	// Defaults to 0.
+	// +optional
+	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
 	// PodUpdatePolicy indicates how pods should be updated
 	// Default value is "ReCreate"
 	// +optional
@@ -78,7 +90,8 @@ type RollingUpdateStatefulSetStrategy struct {
 	// MinReadySeconds works with both OrderedReady and Parallel podManagementPolicy.
 	// It affects the pod scale up speed when the podManagementPolicy is set to be OrderedReady.
 	// Combined with MaxUnavailable, it affects the pod update speed regardless of podManagementPolicy.
-	// Default value is 0, max is 300.
+	// If not specified, spec.minReadySeconds is used, see StatefulSetSpec.GetRollingUpdateMinReadySeconds.
+	// Max is 300.
 	// +optional
 	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
 }
@@ -100,11 +113,13 @@ const (
 	// during Pod update, which is the default behavior
 	RecreatePodUpdateStrategyType PodUpdateStrategyType = "ReCreate"
 	// InPlaceIfPossiblePodUpdateStrategyType indicates that we try to in-place update Pod instead of
-	// recreating Pod when possible. Currently, only image update of pod spec is allowed. Any other changes to the pod
+	// recreating Pod when possible. Currently, only image update of pod spec is allowed, and resources update is also
+	// allowed if the Resources feature is enabled in inPlaceUpdateStrategy. Any other changes to the pod
 	// spec will fall back to ReCreate PodUpdateStrategyType where pod will be recreated.
 	InPlaceIfPossiblePodUpdateStrategyType PodUpdateStrategyType = "InPlaceIfPossible"
 	// InPlaceOnlyPodUpdateStrategyType indicates that we will in-place update Pod instead of
-	// recreating pod. Currently we only allow image update for pod spec. Any other changes to the pod spec will be
+	// recreating pod. Currently we only allow image update for pod spec, and resources update if the Resources
+	// feature is enabled in inPlaceUpdateStrategy. Any other changes to the pod spec will be
 	// rejected by kube-apiserver
 	InPlaceOnlyPodUpdateStrategyType PodUpdateStrategyType = "InPlaceOnly"
 )
@@ -180,6 +195,124 @@ type StatefulSetSpec struct {
 
 	// Lifecycle defines the lifecycle hooks for Pods pre-delete, in-place update.
 	Lifecycle *appspub.Lifecycle `json:"lifecycle,omitempty"`
+
+	// scaleStrategy indicates the StatefulSetScaleStrategy that will be
+	// employed to scale Pods in the StatefulSet.
+	// +optional
+	ScaleStrategy *StatefulSetScaleStrategy `json:"scaleStrategy,omitempty"`
+
+	// PersistentVolumeClaimRetentionPolicy describes the policy used for PVCs created from
+	// the StatefulSet VolumeClaimTemplates. By default, all persistent volume claims are retained.
+	// +optional
+	PersistentVolumeClaimRetentionPolicy *StatefulSetPersistentVolumeClaimRetentionPolicy `json:"persistentVolumeClaimRetentionPolicy,omitempty"`
+
+	// VolumeClaimUpdateStrategy specifies the strategy for updating the PVCs
+	// when the volumeClaimTemplates have been changed.
+	// +optional
+	VolumeClaimUpdateStrategy VolumeClaimUpdateStrategy `json:"volumeClaimUpdateStrategy,omitempty"`
+
+	// ordinals controls the numbering of replica indices in a StatefulSet. The
+	// default ordinals behavior assigns a "0" index to the first replica and
+	// increments the index by one for each additional replica requested.
+	// +optional
+	Ordinals *StatefulSetOrdinals `json:"ordinals,omitempty"`
+
+	// MinReadySeconds is the minimum number of seconds for which a newly created or updated pod
+	// should be ready without any of its container crashing for it to be considered available,
+	// which gates availableReplicas for all pods, not only during rolling update.
+	// During rolling update, rollingUpdate.minReadySeconds takes precedence over it if specified,
+	// otherwise it also applies to the updated pods. Default value is 0, max is 300.
+	// +optional
+	MinReadySeconds *int32 `json:"minReadySeconds,omitempty"`
+}
+
+// StatefulSetOrdinals describes the policy used for replica ordinal assignment
+// in this StatefulSet.
+type StatefulSetOrdinals struct {
+	// start is the number representing the first replica's index. It may be used
+	// to number replicas from an alternate index (eg: 1-indexed) over the default
+	// 0-indexed names, or to orchestrate progressive movement of replicas from
+	// one StatefulSet to another.
+	// If set, replica indices will be in the range:
+	//   [.spec.ordinals.start, .spec.ordinals.start + .spec.replicas).
+	// If unset, defaults to 0. Replica indices will be in the range:
+	//   [0, .spec.replicas).
+	// +optional
+	Start int32 `json:"start"`
+}
+
+// VolumeClaimUpdateStrategyType is a string enumeration type that enumerates
+// all possible ways to update PVCs when the volumeClaimTemplates have been changed.
+type VolumeClaimUpdateStrategyType string
+
+const (
+	// OnPodRollingUpdateVolumeClaimUpdateStrategyType indicates that PVCs will be updated (e.g. expanded
+	// in-place) along with the rolling update of their Pods.
+	OnPodRollingUpdateVolumeClaimUpdateStrategyType VolumeClaimUpdateStrategyType = "OnPodRollingUpdate"
+	// OnDeleteVolumeClaimUpdateStrategyType indicates that PVCs will only be recreated with the new
+	// volumeClaimTemplates when they have been deleted, which is the default behavior.
+	OnDeleteVolumeClaimUpdateStrategyType VolumeClaimUpdateStrategyType = "OnDelete"
+)
+
+// VolumeClaimUpdateStrategy defines the strategy for updating PVCs.
+type VolumeClaimUpdateStrategy struct {
+	// Type specifies the type of VolumeClaimUpdateStrategy.
+	// Default value is OnDelete.
+	// +optional
+	Type VolumeClaimUpdateStrategyType `json:"type,omitempty"`
+}
+
+// PersistentVolumeClaimRetentionPolicyType is a string enumeration of the policies that will determine
+// when volumes from the VolumeClaimTemplates will be deleted when the controlling StatefulSet is
+// deleted or scaled down.
+type PersistentVolumeClaimRetentionPolicyType string
+
+const (
+	// RetainPersistentVolumeClaimRetentionPolicyType is the default
+	// PersistentVolumeClaimRetentionPolicy and specifies that
+	// PersistentVolumeClaims associated with StatefulSet VolumeClaimTemplates
+	// will not be deleted.
+	RetainPersistentVolumeClaimRetentionPolicyType PersistentVolumeClaimRetentionPolicyType = "Retain"
+	// DeletePersistentVolumeClaimRetentionPolicyType specifies that
+	// PersistentVolumeClaims associated with StatefulSet VolumeClaimTemplates
+	// will be deleted in the scenario specified in
+	// StatefulSetPersistentVolumeClaimRetentionPolicy.
+	DeletePersistentVolumeClaimRetentionPolicyType PersistentVolumeClaimRetentionPolicyType = "Delete"
+)
+
+// StatefulSetPersistentVolumeClaimRetentionPolicy describes the policy used for PVCs
+// created from the StatefulSet VolumeClaimTemplates.
+type StatefulSetPersistentVolumeClaimRetentionPolicy struct {
+	// WhenDeleted specifies what happens to PVCs created from StatefulSet
+	// VolumeClaimTemplates when the StatefulSet is deleted. The default policy
+	// of `Retain` causes PVCs to not be affected by StatefulSet deletion. The
+	// `Delete` policy causes those PVCs to be deleted.
+	// +optional
+	WhenDeleted PersistentVolumeClaimRetentionPolicyType `json:"whenDeleted,omitempty"`
+	// WhenScaled specifies what happens to PVCs created from StatefulSet
+	// VolumeClaimTemplates when the StatefulSet is scaled down. The default
+	// policy of `Retain` causes PVCs to not be affected by a scaledown. The
+	// `Delete` policy causes the associated PVCs for any excess pods above
+	// the replica count to be deleted.
+	// +optional
+	WhenScaled PersistentVolumeClaimRetentionPolicyType `json:"whenScaled,omitempty"`
+}
+
+// StatefulSetScaleStrategy defines strategies for pods scale.
+type StatefulSetScaleStrategy struct {
+	// The maximum number of pods that can be unavailable during scaling.
+	// Value can be an absolute number (ex: 5) or a percentage of desired pods (ex: 10%).
+	// Absolute number is calculated from percentage by rounding down.
+	// It can just be allowed to work with Parallel podManagementPolicy.
+	// Defaults to nil, which means no limit.
+	// +optional
+	MaxUnavailable *intstr.IntOrString `json:"maxUnavailable,omitempty"`
+
+	// PodsToDelete is the names of Pod should be deleted when replicas is reduced.
+	// Names must follow the pattern of $(statefulset name)-$(ordinal).
+	// Note that this list will be truncated for non-existing pod names.
+	// +optional
+	PodsToDelete []string `json:"podsToDelete,omitempty"`
 }
 
 // StatefulSetStatus defines the observed state of StatefulSet
@@ -207,6 +340,16 @@ type StatefulSetStatus struct {
 	// indicated by updateRevision.
 	UpdatedReplicas int32 `json:"updatedReplicas"`
 
+	// updatedReadyReplicas is the number of Pods created by the StatefulSet controller from the StatefulSet version
+	// indicated by updateRevision and have a Ready Condition.
+	// +optional
+	UpdatedReadyReplicas int32 `json:"updatedReadyReplicas,omitempty"`
+
+	// updatedAvailableReplicas is the number of Pods created by the StatefulSet controller from the StatefulSet version
+	// indicated by updateRevision and have been ready for minReadySeconds.
+	// +optional
+	UpdatedAvailableReplicas int32 `json:"updatedAvailableReplicas,omitempty"`
+
 	// currentRevision, if not empty, indicates the version of the StatefulSet used to generate Pods in the
 	// sequence [0,currentReplicas).
 	CurrentRevision string `json:"currentRevision,omitempty"`
@@ -229,6 +372,25 @@ type StatefulSetStatus struct {
 
 	// LabelSelector is label selectors for query over pods that should match the replica count used by HPA.
 	LabelSelector string `json:"labelSelector,omitempty"`
+
+	// VolumeClaims represents the status of compatibility between existing PVCs
+	// and their respective volumeClaimTemplates, one item for each volumeClaimTemplate.
+	// +optional
+	VolumeClaims []VolumeClaimStatus `json:"volumeClaims,omitempty"`
+}
+
+// VolumeClaimStatus records the PVCs status of a volumeClaimTemplate.
+type VolumeClaimStatus struct {
+	// VolumeClaimName is the name of the volumeClaimTemplate.
+	VolumeClaimName string `json:"volumeClaimName"`
+	// Replicas is the number of PVCs created from the volumeClaimTemplate.
+	Replicas int32 `json:"replicas"`
+	// CompatibleReplicas is the number of Pods whose PVCs are compatible with the volumeClaimTemplate,
+	// e.g. have been expanded to the requested size.
+	CompatibleReplicas int32 `json:"compatibleReplicas"`
+	// CompatibleReadyReplicas is the number of Pods whose PVCs are compatible with the volumeClaimTemplate
+	// and have a Ready Condition.
+	CompatibleReadyReplicas int32 `json:"compatibleReadyReplicas"`
 }
 
 // These are valid conditions of a statefulset.
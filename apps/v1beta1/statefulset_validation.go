@@ -0,0 +1,172 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	apps "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateParallelRequirements checks the features which can only work with Parallel podManagementPolicy,
+// and returns one error for each of them used with other policies. fldPath should be the path of spec.
+func ValidateParallelRequirements(spec *StatefulSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.PodManagementPolicy == apps.ParallelPodManagement {
+		return allErrs
+	}
+
+	if spec.ScaleStrategy != nil && spec.ScaleStrategy.MaxUnavailable != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("scaleStrategy", "maxUnavailable"),
+			"scaleStrategy.maxUnavailable can just work with Parallel podManagementPolicy"))
+	}
+	if spec.UpdateStrategy.RollingUpdate == nil {
+		return allErrs
+	}
+
+	rollingUpdate := spec.UpdateStrategy.RollingUpdate
+	rollingUpdatePath := fldPath.Child("updateStrategy", "rollingUpdate")
+	if rollingUpdate.MaxUnavailable != nil {
+		allErrs = append(allErrs, field.Forbidden(rollingUpdatePath.Child("maxUnavailable"),
+			"maxUnavailable can just work with Parallel podManagementPolicy"))
+	}
+	if rollingUpdate.UnorderedUpdate != nil {
+		allErrs = append(allErrs, field.Forbidden(rollingUpdatePath.Child("unorderedUpdate"),
+			"unorderedUpdate can just work with Parallel podManagementPolicy"))
+	}
+	if rollingUpdate.MaxSurge != nil {
+		allErrs = append(allErrs, field.Forbidden(rollingUpdatePath.Child("maxSurge"),
+			"maxSurge can just work with Parallel podManagementPolicy"))
+	}
+	return allErrs
+}
+
+// ValidateMaxSurge checks maxSurge in rolling update strategy is a valid non-negative value.
+// Whether it is used with Parallel podManagementPolicy is checked by ValidateParallelRequirements.
+func ValidateMaxSurge(spec *StatefulSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.UpdateStrategy.RollingUpdate == nil || spec.UpdateStrategy.RollingUpdate.MaxSurge == nil {
+		return allErrs
+	}
+
+	maxSurge := spec.UpdateStrategy.RollingUpdate.MaxSurge
+	maxSurgePath := fldPath.Child("updateStrategy", "rollingUpdate", "maxSurge")
+	if value, err := intstr.GetValueFromIntOrPercent(maxSurge, 100, true); err != nil {
+		allErrs = append(allErrs, field.Invalid(maxSurgePath, maxSurge.String(), err.Error()))
+	} else if value < 0 {
+		allErrs = append(allErrs, field.Invalid(maxSurgePath, maxSurge.String(), "must be greater than or equal to 0"))
+	}
+	return allErrs
+}
+
+// ValidatePartition checks partition and partitionPercent in rolling update strategy
+// are non-negative and not set together.
+func ValidatePartition(spec *StatefulSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.UpdateStrategy.RollingUpdate == nil {
+		return allErrs
+	}
+
+	rollingUpdate := spec.UpdateStrategy.RollingUpdate
+	rollingUpdatePath := fldPath.Child("updateStrategy", "rollingUpdate")
+	if rollingUpdate.Partition != nil && *rollingUpdate.Partition < 0 {
+		allErrs = append(allErrs, field.Invalid(rollingUpdatePath.Child("partition"), *rollingUpdate.Partition, "must be greater than or equal to 0"))
+	}
+	if rollingUpdate.PartitionPercent != nil {
+		if rollingUpdate.Partition != nil {
+			allErrs = append(allErrs, field.Forbidden(rollingUpdatePath.Child("partitionPercent"), "can not be set together with partition"))
+		}
+		if value, err := intstr.GetValueFromIntOrPercent(rollingUpdate.PartitionPercent, 100, true); err != nil {
+			allErrs = append(allErrs, field.Invalid(rollingUpdatePath.Child("partitionPercent"), rollingUpdate.PartitionPercent.String(), err.Error()))
+		} else if value < 0 {
+			allErrs = append(allErrs, field.Invalid(rollingUpdatePath.Child("partitionPercent"), rollingUpdate.PartitionPercent.String(), "must be greater than or equal to 0"))
+		}
+	}
+	return allErrs
+}
+
+// ValidatePodsToDelete checks the names in scaleStrategy.podsToDelete follow the pod naming
+// pattern of the StatefulSet, which is $(statefulset name)-$(ordinal), and are not duplicated.
+// name is the name of the StatefulSet and fldPath should be the path of spec.
+func ValidatePodsToDelete(spec *StatefulSetSpec, name string, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.ScaleStrategy == nil {
+		return allErrs
+	}
+
+	podsToDeletePath := fldPath.Child("scaleStrategy", "podsToDelete")
+	prefix := name + "-"
+	names := make(map[string]struct{}, len(spec.ScaleStrategy.PodsToDelete))
+	for i, podName := range spec.ScaleStrategy.PodsToDelete {
+		if _, ok := names[podName]; ok {
+			allErrs = append(allErrs, field.Duplicate(podsToDeletePath.Index(i), podName))
+			continue
+		}
+		names[podName] = struct{}{}
+
+		if !strings.HasPrefix(podName, prefix) {
+			allErrs = append(allErrs, field.Invalid(podsToDeletePath.Index(i), podName, "must be in the form of "+prefix+"$(ordinal)"))
+			continue
+		}
+		suffix := strings.TrimPrefix(podName, prefix)
+		if ordinal, err := strconv.Atoi(suffix); err != nil || ordinal < 0 || strconv.Itoa(ordinal) != suffix {
+			allErrs = append(allErrs, field.Invalid(podsToDeletePath.Index(i), podName, "must be in the form of "+prefix+"$(ordinal)"))
+		}
+	}
+	return allErrs
+}
+
+// ValidateMinReadySeconds checks both spec.minReadySeconds and rollingUpdate.minReadySeconds
+// are in the range of [0, MaxMinReadySeconds].
+func ValidateMinReadySeconds(spec *StatefulSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil {
+		return allErrs
+	}
+
+	allErrs = append(allErrs, validateMinReadySecondsRange(spec.MinReadySeconds, fldPath.Child("minReadySeconds"))...)
+	if spec.UpdateStrategy.RollingUpdate != nil {
+		allErrs = append(allErrs, validateMinReadySecondsRange(spec.UpdateStrategy.RollingUpdate.MinReadySeconds,
+			fldPath.Child("updateStrategy", "rollingUpdate", "minReadySeconds"))...)
+	}
+	return allErrs
+}
+
+func validateMinReadySecondsRange(v *int32, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if v != nil && (*v < 0 || *v > MaxMinReadySeconds) {
+		allErrs = append(allErrs, field.Invalid(fldPath, *v, fmt.Sprintf("must be in the range of [0, %d]", MaxMinReadySeconds)))
+	}
+	return allErrs
+}
+
+// ValidateOrdinals checks spec.ordinals.start is non-negative.
+func ValidateOrdinals(spec *StatefulSetSpec, fldPath *field.Path) field.ErrorList {
+	var allErrs field.ErrorList
+	if spec == nil || spec.Ordinals == nil {
+		return allErrs
+	}
+
+	if spec.Ordinals.Start < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("ordinals", "start"), spec.Ordinals.Start, "must be greater than or equal to 0"))
+	}
+	return allErrs
+}
@@ -0,0 +1,257 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"testing"
+
+	apps "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+func TestValidateParallelRequirements(t *testing.T) {
+	maxUnavailable := intstr.FromInt(2)
+	maxSurge := intstr.FromString("10%")
+	cases := []struct {
+		name           string
+		policy         apps.PodManagementPolicyType
+		scaleStrategy  *StatefulSetScaleStrategy
+		rollingUpdate  *RollingUpdateStatefulSetStrategy
+		expectedFields []string
+	}{
+		{
+			name:   "no rolling update",
+			policy: apps.OrderedReadyPodManagement,
+		},
+		{
+			name:          "maxUnavailable with OrderedReady",
+			policy:        apps.OrderedReadyPodManagement,
+			rollingUpdate: &RollingUpdateStatefulSetStrategy{MaxUnavailable: &maxUnavailable},
+			expectedFields: []string{
+				"spec.updateStrategy.rollingUpdate.maxUnavailable",
+			},
+		},
+		{
+			name:          "scaleStrategy.maxUnavailable with OrderedReady",
+			policy:        apps.OrderedReadyPodManagement,
+			scaleStrategy: &StatefulSetScaleStrategy{MaxUnavailable: &maxUnavailable},
+			expectedFields: []string{
+				"spec.scaleStrategy.maxUnavailable",
+			},
+		},
+		{
+			name:          "scaleStrategy without maxUnavailable with OrderedReady",
+			policy:        apps.OrderedReadyPodManagement,
+			scaleStrategy: &StatefulSetScaleStrategy{PodsToDelete: []string{"foo-0"}},
+		},
+		{
+			name:          "unorderedUpdate with OrderedReady",
+			policy:        apps.OrderedReadyPodManagement,
+			rollingUpdate: &RollingUpdateStatefulSetStrategy{UnorderedUpdate: &UnorderedUpdateStrategy{}},
+			expectedFields: []string{
+				"spec.updateStrategy.rollingUpdate.unorderedUpdate",
+			},
+		},
+		{
+			name:          "maxSurge with OrderedReady",
+			policy:        apps.OrderedReadyPodManagement,
+			rollingUpdate: &RollingUpdateStatefulSetStrategy{MaxSurge: &maxSurge},
+			expectedFields: []string{
+				"spec.updateStrategy.rollingUpdate.maxSurge",
+			},
+		},
+		{
+			name:          "all features with OrderedReady",
+			policy:        apps.OrderedReadyPodManagement,
+			scaleStrategy: &StatefulSetScaleStrategy{MaxUnavailable: &maxUnavailable},
+			rollingUpdate: &RollingUpdateStatefulSetStrategy{
+				MaxUnavailable:  &maxUnavailable,
+				UnorderedUpdate: &UnorderedUpdateStrategy{},
+				MaxSurge:        &maxSurge,
+			},
+			expectedFields: []string{
+				"spec.scaleStrategy.maxUnavailable",
+				"spec.updateStrategy.rollingUpdate.maxUnavailable",
+				"spec.updateStrategy.rollingUpdate.unorderedUpdate",
+				"spec.updateStrategy.rollingUpdate.maxSurge",
+			},
+		},
+		{
+			name:          "all features with Parallel",
+			policy:        apps.ParallelPodManagement,
+			scaleStrategy: &StatefulSetScaleStrategy{MaxUnavailable: &maxUnavailable},
+			rollingUpdate: &RollingUpdateStatefulSetStrategy{
+				MaxUnavailable:  &maxUnavailable,
+				UnorderedUpdate: &UnorderedUpdateStrategy{},
+				MaxSurge:        &maxSurge,
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &StatefulSetSpec{
+				PodManagementPolicy: tc.policy,
+				ScaleStrategy:       tc.scaleStrategy,
+				UpdateStrategy:      StatefulSetUpdateStrategy{RollingUpdate: tc.rollingUpdate},
+			}
+			errs := ValidateParallelRequirements(spec, field.NewPath("spec"))
+			if len(errs) != len(tc.expectedFields) {
+				t.Fatalf("expected %d errors, got %v", len(tc.expectedFields), errs)
+			}
+			for i, err := range errs {
+				if err.Type != field.ErrorTypeForbidden || err.Field != tc.expectedFields[i] {
+					t.Errorf("expected forbidden error on %s, got %v", tc.expectedFields[i], err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateMaxSurge(t *testing.T) {
+	cases := []struct {
+		name        string
+		maxSurge    intstr.IntOrString
+		expectError bool
+	}{
+		{
+			name:     "int",
+			maxSurge: intstr.FromInt(1),
+		},
+		{
+			name:     "percent",
+			maxSurge: intstr.FromString("50%"),
+		},
+		{
+			name:        "negative int",
+			maxSurge:    intstr.FromInt(-1),
+			expectError: true,
+		},
+		{
+			name:        "invalid percent",
+			maxSurge:    intstr.FromString("abc"),
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// The policy is left to ValidateParallelRequirements, so OrderedReady must not be reported here.
+			spec := &StatefulSetSpec{
+				PodManagementPolicy: apps.OrderedReadyPodManagement,
+				UpdateStrategy: StatefulSetUpdateStrategy{
+					RollingUpdate: &RollingUpdateStatefulSetStrategy{MaxSurge: &tc.maxSurge},
+				},
+			}
+			errs := ValidateMaxSurge(spec, field.NewPath("spec"))
+			if tc.expectError != (len(errs) > 0) {
+				t.Fatalf("expected error %v, got %v", tc.expectError, errs)
+			}
+			for _, err := range errs {
+				if err.Field != "spec.updateStrategy.rollingUpdate.maxSurge" {
+					t.Errorf("expected error on spec.updateStrategy.rollingUpdate.maxSurge, got %s", err.Field)
+				}
+			}
+		})
+	}
+}
+
+func TestValidateOrdinals(t *testing.T) {
+	cases := []struct {
+		name        string
+		ordinals    *StatefulSetOrdinals
+		expectError bool
+	}{
+		{
+			name: "nil",
+		},
+		{
+			name:     "zero",
+			ordinals: &StatefulSetOrdinals{Start: 0},
+		},
+		{
+			name:     "positive",
+			ordinals: &StatefulSetOrdinals{Start: 3},
+		},
+		{
+			name:        "negative",
+			ordinals:    &StatefulSetOrdinals{Start: -1},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			errs := ValidateOrdinals(&StatefulSetSpec{Ordinals: tc.ordinals}, field.NewPath("spec"))
+			if tc.expectError != (len(errs) > 0) {
+				t.Fatalf("expected error %v, got %v", tc.expectError, errs)
+			}
+			for _, err := range errs {
+				if err.Field != "spec.ordinals.start" || err.Type != field.ErrorTypeInvalid {
+					t.Errorf("expected invalid error on spec.ordinals.start, got %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestValidatePodsToDelete(t *testing.T) {
+	cases := []struct {
+		name         string
+		podsToDelete []string
+		expectErrors []string
+	}{
+		{
+			name:         "valid",
+			podsToDelete: []string{"sts-0", "sts-12"},
+		},
+		{
+			name:         "duplicated",
+			podsToDelete: []string{"sts-1", "sts-1"},
+			expectErrors: []string{"spec.scaleStrategy.podsToDelete[1]: Duplicate value: \"sts-1\""},
+		},
+		{
+			name:         "other statefulset",
+			podsToDelete: []string{"foo-1"},
+			expectErrors: []string{"spec.scaleStrategy.podsToDelete[0]: Invalid value: \"foo-1\": must be in the form of sts-$(ordinal)"},
+		},
+		{
+			name:         "invalid ordinal",
+			podsToDelete: []string{"sts-01", "sts-a"},
+			expectErrors: []string{
+				"spec.scaleStrategy.podsToDelete[0]: Invalid value: \"sts-01\": must be in the form of sts-$(ordinal)",
+				"spec.scaleStrategy.podsToDelete[1]: Invalid value: \"sts-a\": must be in the form of sts-$(ordinal)",
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			spec := &StatefulSetSpec{ScaleStrategy: &StatefulSetScaleStrategy{PodsToDelete: tc.podsToDelete}}
+			errs := ValidatePodsToDelete(spec, "sts", field.NewPath("spec"))
+			if len(errs) != len(tc.expectErrors) {
+				t.Fatalf("expected %d errors, got %v", len(tc.expectErrors), errs)
+			}
+			for i, err := range errs {
+				if err.Error() != tc.expectErrors[i] {
+					t.Errorf("expected error %q, got %q", tc.expectErrors[i], err.Error())
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,142 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"fmt"
+
+	appsv1beta1 "github.com/openkruise/kruise-api/apps/v1beta1"
+	apps "k8s.io/api/apps/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+// ValidateStatefulSet validates the Advanced StatefulSet in v1beta1.
+func ValidateStatefulSet(set *appsv1beta1.StatefulSet) field.ErrorList {
+	specPath := field.NewPath("spec")
+	allErrs := ValidateStatefulSetSpec(&set.Spec, specPath)
+	allErrs = append(allErrs, appsv1beta1.ValidatePodsToDelete(&set.Spec, set.Name, specPath)...)
+	return allErrs
+}
+
+// ValidateStatefulSetSpec validates the spec of Advanced StatefulSet in v1beta1, fldPath should be the path of spec.
+// It only covers the constraints introduced by Advanced StatefulSet, the pod template should be validated
+// by the caller with the upstream validation of core types. scaleStrategy.podsToDelete depends on the name
+// of the StatefulSet, so it is checked by ValidateStatefulSet.
+func ValidateStatefulSetSpec(spec *appsv1beta1.StatefulSetSpec, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if spec.Replicas != nil && *spec.Replicas < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("replicas"), *spec.Replicas, "must be greater than or equal to 0"))
+	}
+	if spec.RevisionHistoryLimit != nil && *spec.RevisionHistoryLimit < 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("revisionHistoryLimit"), *spec.RevisionHistoryLimit, "must be greater than or equal to 0"))
+	}
+
+	switch spec.PodManagementPolicy {
+	case "", apps.OrderedReadyPodManagement, apps.ParallelPodManagement:
+	default:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("podManagementPolicy"), spec.PodManagementPolicy,
+			fmt.Sprintf("must be '%s' or '%s'", apps.OrderedReadyPodManagement, apps.ParallelPodManagement)))
+	}
+
+	if spec.Selector == nil {
+		allErrs = append(allErrs, field.Required(fldPath.Child("selector"), ""))
+	} else if len(spec.Selector.MatchLabels)+len(spec.Selector.MatchExpressions) == 0 {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("selector"), spec.Selector, "empty selector is invalid for statefulset"))
+	}
+
+	allErrs = append(allErrs, appsv1beta1.ValidateParallelRequirements(spec, fldPath)...)
+	allErrs = append(allErrs, validateUpdateStrategy(&spec.UpdateStrategy, fldPath.Child("updateStrategy"))...)
+	allErrs = append(allErrs, appsv1beta1.ValidateMaxSurge(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1beta1.ValidatePartition(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1beta1.ValidateMinReadySeconds(spec, fldPath)...)
+	allErrs = append(allErrs, appsv1beta1.ValidateOrdinals(spec, fldPath)...)
+	return allErrs
+}
+
+func validateUpdateStrategy(strategy *appsv1beta1.StatefulSetUpdateStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	switch strategy.Type {
+	case "":
+		allErrs = append(allErrs, field.Required(fldPath.Child("type"), ""))
+	case apps.OnDeleteStatefulSetStrategyType:
+		if strategy.RollingUpdate != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("rollingUpdate"), strategy.RollingUpdate,
+				fmt.Sprintf("only allowed for updateStrategy '%s'", apps.RollingUpdateStatefulSetStrategyType)))
+		}
+	case apps.RollingUpdateStatefulSetStrategyType:
+		if strategy.RollingUpdate != nil {
+			allErrs = append(allErrs, validateRollingUpdate(strategy.RollingUpdate, fldPath.Child("rollingUpdate"))...)
+		}
+	default:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("type"), strategy.Type,
+			fmt.Sprintf("must be '%s' or '%s'", apps.RollingUpdateStatefulSetStrategyType, apps.OnDeleteStatefulSetStrategyType)))
+	}
+	return allErrs
+}
+
+// validateRollingUpdate checks the fields of rolling update strategy that are not covered by
+// ValidateMaxSurge, ValidatePartition and ValidateMinReadySeconds.
+func validateRollingUpdate(rollingUpdate *appsv1beta1.RollingUpdateStatefulSetStrategy, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if rollingUpdate.MaxUnavailable != nil {
+		if value, err := intstr.GetValueFromIntOrPercent(rollingUpdate.MaxUnavailable, 1, true); err != nil {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), rollingUpdate.MaxUnavailable.String(), err.Error()))
+		} else if value < 0 {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("maxUnavailable"), rollingUpdate.MaxUnavailable.String(), "must be greater than or equal to 0"))
+		}
+	}
+
+	switch rollingUpdate.PodUpdatePolicy {
+	case "", appsv1beta1.RecreatePodUpdateStrategyType, appsv1beta1.InPlaceIfPossiblePodUpdateStrategyType, appsv1beta1.InPlaceOnlyPodUpdateStrategyType:
+	default:
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("podUpdatePolicy"), rollingUpdate.PodUpdatePolicy,
+			fmt.Sprintf("must be '%s', '%s' or '%s'", appsv1beta1.RecreatePodUpdateStrategyType,
+				appsv1beta1.InPlaceIfPossiblePodUpdateStrategyType, appsv1beta1.InPlaceOnlyPodUpdateStrategyType)))
+	}
+	return allErrs
+}
+
+// ValidateStatefulSetUpdate validates the update of Advanced StatefulSet in v1beta1. Besides the validation of
+// new StatefulSet, 'selector', 'volumeClaimTemplates', 'serviceName' and 'podManagementPolicy' are not allowed
+// to be changed.
+func ValidateStatefulSetUpdate(newSet, oldSet *appsv1beta1.StatefulSet) field.ErrorList {
+	allErrs := ValidateStatefulSet(newSet)
+
+	newSpec := newSet.Spec.DeepCopy()
+	oldSpec := oldSet.Spec.DeepCopy()
+	newSpec.Replicas = oldSpec.Replicas
+	newSpec.Template = oldSpec.Template
+	newSpec.UpdateStrategy = oldSpec.UpdateStrategy
+	newSpec.RevisionHistoryLimit = oldSpec.RevisionHistoryLimit
+	newSpec.ReserveOrdinals = oldSpec.ReserveOrdinals
+	newSpec.Lifecycle = oldSpec.Lifecycle
+	newSpec.ScaleStrategy = oldSpec.ScaleStrategy
+	newSpec.PersistentVolumeClaimRetentionPolicy = oldSpec.PersistentVolumeClaimRetentionPolicy
+	newSpec.VolumeClaimUpdateStrategy = oldSpec.VolumeClaimUpdateStrategy
+	newSpec.Ordinals = oldSpec.Ordinals
+	newSpec.MinReadySeconds = oldSpec.MinReadySeconds
+	if !apiequality.Semantic.DeepEqual(newSpec, oldSpec) {
+		allErrs = append(allErrs, field.Forbidden(field.NewPath("spec"),
+			"updates to statefulset spec for fields 'selector', 'volumeClaimTemplates', 'serviceName' and 'podManagementPolicy' are forbidden"))
+	}
+	return allErrs
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validation
+
+import (
+	"testing"
+
+	appsv1beta1 "github.com/openkruise/kruise-api/apps/v1beta1"
+	apps "k8s.io/api/apps/v1"
+	apiequality "k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/intstr"
+)
+
+func newStatefulSet() *appsv1beta1.StatefulSet {
+	return &appsv1beta1.StatefulSet{
+		ObjectMeta: metav1.ObjectMeta{Name: "sts"},
+		Spec: appsv1beta1.StatefulSetSpec{
+			Replicas:       int32Ptr(3),
+			Selector:       &metav1.LabelSelector{MatchLabels: map[string]string{"app": "demo"}},
+			ServiceName:    "demo",
+			UpdateStrategy: appsv1beta1.StatefulSetUpdateStrategy{Type: apps.RollingUpdateStatefulSetStrategyType},
+		},
+	}
+}
+
+func TestValidateStatefulSet(t *testing.T) {
+	maxSurge := intstr.FromString("abc")
+	partitionPercent := intstr.FromString("10%")
+	cases := []struct {
+		name         string
+		modify       func(set *appsv1beta1.StatefulSet)
+		expectErrors []string
+	}{
+		{
+			name:   "valid",
+			modify: func(set *appsv1beta1.StatefulSet) {},
+		},
+		{
+			name: "rollingUpdate in OrderedReady",
+			modify: func(set *appsv1beta1.StatefulSet) {
+				set.Spec.UpdateStrategy.RollingUpdate = &appsv1beta1.RollingUpdateStatefulSetStrategy{MaxSurge: &maxSurge}
+			},
+			expectErrors: []string{
+				"spec.updateStrategy.rollingUpdate.maxSurge: Forbidden: maxSurge can just work with Parallel podManagementPolicy",
+				"spec.updateStrategy.rollingUpdate.maxSurge: Invalid value: \"abc\": invalid value for IntOrString: invalid value \"abc\": strconv.Atoi: parsing \"abc\": invalid syntax",
+			},
+		},
+		{
+			name: "partition with partitionPercent",
+			modify: func(set *appsv1beta1.StatefulSet) {
+				set.Spec.UpdateStrategy.RollingUpdate = &appsv1beta1.RollingUpdateStatefulSetStrategy{
+					Partition:        int32Ptr(1),
+					PartitionPercent: &partitionPercent,
+				}
+			},
+			expectErrors: []string{"spec.updateStrategy.rollingUpdate.partitionPercent: Forbidden: can not be set together with partition"},
+		},
+		{
+			name:         "minReadySeconds out of range",
+			modify:       func(set *appsv1beta1.StatefulSet) { set.Spec.MinReadySeconds = int32Ptr(-1) },
+			expectErrors: []string{"spec.minReadySeconds: Invalid value: -1: must be in the range of [0, 300]"},
+		},
+		{
+			name:         "negative ordinals start",
+			modify:       func(set *appsv1beta1.StatefulSet) { set.Spec.Ordinals = &appsv1beta1.StatefulSetOrdinals{Start: -1} },
+			expectErrors: []string{"spec.ordinals.start: Invalid value: -1: must be greater than or equal to 0"},
+		},
+		{
+			name: "invalid podsToDelete",
+			modify: func(set *appsv1beta1.StatefulSet) {
+				set.Spec.ScaleStrategy = &appsv1beta1.StatefulSetScaleStrategy{PodsToDelete: []string{"foo-1"}}
+			},
+			expectErrors: []string{"spec.scaleStrategy.podsToDelete[0]: Invalid value: \"foo-1\": must be in the form of sts-$(ordinal)"},
+		},
+		{
+			name:         "missing selector",
+			modify:       func(set *appsv1beta1.StatefulSet) { set.Spec.Selector = nil },
+			expectErrors: []string{"spec.selector: Required value"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			set := newStatefulSet()
+			tc.modify(set)
+			errs := ValidateStatefulSet(set)
+			if len(errs) != len(tc.expectErrors) {
+				t.Fatalf("expected %d errors, got %v", len(tc.expectErrors), errs)
+			}
+			for i, err := range errs {
+				if err.Error() != tc.expectErrors[i] {
+					t.Errorf("expected error %q, got %q", tc.expectErrors[i], err.Error())
+				}
+			}
+		})
+	}
+}
+
+func TestValidateStatefulSetUpdate(t *testing.T) {
+	cases := []struct {
+		name         string
+		modify       func(set *appsv1beta1.StatefulSet)
+		expectErrors []string
+	}{
+		{
+			name: "allowed fields changed",
+			modify: func(set *appsv1beta1.StatefulSet) {
+				set.Spec.Replicas = int32Ptr(5)
+				set.Spec.ReserveOrdinals = []int{1}
+				set.Spec.MinReadySeconds = int32Ptr(10)
+				set.Spec.Ordinals = &appsv1beta1.StatefulSetOrdinals{Start: 1}
+				set.Spec.ScaleStrategy = &appsv1beta1.StatefulSetScaleStrategy{PodsToDelete: []string{"sts-1"}}
+			},
+		},
+		{
+			name:   "serviceName changed",
+			modify: func(set *appsv1beta1.StatefulSet) { set.Spec.ServiceName = "other" },
+			expectErrors: []string{"spec: Forbidden: updates to statefulset spec for fields " +
+				"'selector', 'volumeClaimTemplates', 'serviceName' and 'podManagementPolicy' are forbidden"},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			oldSet := newStatefulSet()
+			newSet := oldSet.DeepCopy()
+			tc.modify(newSet)
+			expectedNewSet := newSet.DeepCopy()
+
+			errs := ValidateStatefulSetUpdate(newSet, oldSet)
+			if len(errs) != len(tc.expectErrors) {
+				t.Fatalf("expected %d errors, got %v", len(tc.expectErrors), errs)
+			}
+			for i, err := range errs {
+				if err.Error() != tc.expectErrors[i] {
+					t.Errorf("expected error %q, got %q", tc.expectErrors[i], err.Error())
+				}
+			}
+			if !apiequality.Semantic.DeepEqual(newSet, expectedNewSet) {
+				t.Fatalf("expected new StatefulSet not modified, got %v", newSet)
+			}
+		})
+	}
+}
+
+func int32Ptr(v int32) *int32 {
+	return &v
+}
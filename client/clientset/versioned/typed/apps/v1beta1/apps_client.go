@@ -26,6 +26,8 @@ import (
 
 type AppsV1beta1Interface interface {
 	RESTClient() rest.Interface
+	BroadcastJobsGetter
+	CloneSetsGetter
 	StatefulSetsGetter
 }
 
@@ -34,6 +36,14 @@ type AppsV1beta1Client struct {
 	restClient rest.Interface
 }
 
+func (c *AppsV1beta1Client) BroadcastJobs(namespace string) BroadcastJobInterface {
+	return newBroadcastJobs(c, namespace)
+}
+
+func (c *AppsV1beta1Client) CloneSets(namespace string) CloneSetInterface {
+	return newCloneSets(c, namespace)
+}
+
 func (c *AppsV1beta1Client) StatefulSets(namespace string) StatefulSetInterface {
 	return newStatefulSets(c, namespace)
 }
@@ -28,6 +28,14 @@ type FakeAppsV1beta1 struct {
 	*testing.Fake
 }
 
+func (c *FakeAppsV1beta1) BroadcastJobs(namespace string) v1beta1.BroadcastJobInterface {
+	return &FakeBroadcastJobs{c, namespace}
+}
+
+func (c *FakeAppsV1beta1) CloneSets(namespace string) v1beta1.CloneSetInterface {
+	return &FakeCloneSets{c, namespace}
+}
+
 func (c *FakeAppsV1beta1) StatefulSets(namespace string) v1beta1.StatefulSetInterface {
 	return &FakeStatefulSets{c, namespace}
 }
@@ -0,0 +1,75 @@
+/*
+Copyright 2021 The Kruise Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statefulset
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// PodNameLabel is the label set on pods by StatefulSet controller with the pod name,
+	// which can be used to select a single pod by a Service.
+	PodNameLabel = "statefulset.kubernetes.io/pod-name"
+	// PodIndexLabel is the label set on pods by StatefulSet controller with the ordinal of pod.
+	PodIndexLabel = "apps.kubernetes.io/pod-index"
+)
+
+// statefulPodRegex is a regular expression that extracts the parent StatefulSet and ordinal from the Name of a Pod.
+var statefulPodRegex = regexp.MustCompile("(.*)-([0-9]+)$")
+
+// GetParentNameAndOrdinal gets the name of pod's parent StatefulSet and pod's ordinal as extracted from its Name.
+// If the Pod was not created by a StatefulSet, its parent is considered to be empty string, and its ordinal is
+// considered to be -1.
+func GetParentNameAndOrdinal(pod metav1.Object) (string, int) {
+	parent := ""
+	ordinal := -1
+	subMatches := statefulPodRegex.FindStringSubmatch(pod.GetName())
+	if len(subMatches) < 3 {
+		return parent, ordinal
+	}
+	parent = subMatches[1]
+	if i, err := strconv.ParseInt(subMatches[2], 10, 32); err == nil {
+		ordinal = int(i)
+	}
+	return parent, ordinal
+}
+
+// GetOrdinal gets pod's ordinal. If pod has no ordinal, -1 is returned.
+func GetOrdinal(pod metav1.Object) int {
+	_, ordinal := GetParentNameAndOrdinal(pod)
+	return ordinal
+}
+
+// IsMemberOf tests if pod is a member of set, which means they are in the same namespace
+// and the pod name is generated from the set name.
+// The set could be StatefulSet of either apps/v1, apps.kruise.io/v1alpha1 or apps.kruise.io/v1beta1.
+func IsMemberOf(set, pod metav1.Object) bool {
+	if set.GetNamespace() != pod.GetNamespace() {
+		return false
+	}
+	parent, _ := GetParentNameAndOrdinal(pod)
+	return parent == set.GetName()
+}
+
+// PodName gets the name of set's child Pod with an ordinal index of ordinal.
+func PodName(set metav1.Object, ordinal int) string {
+	return fmt.Sprintf("%s-%d", set.GetName(), ordinal)
+}